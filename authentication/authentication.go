@@ -3,10 +3,12 @@ package authentication
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ConsultingMD/go-auth0/internal/client"
@@ -111,7 +113,9 @@ func (u *UserInfoResponse) UnmarshalJSON(b []byte) error {
 
 // Authentication is the auth client.
 type Authentication struct {
+	CIBA         *CIBA
 	Database     *Database
+	Logout       *Logout
 	OAuth        *OAuth
 	Passwordless *Passwordless
 
@@ -129,6 +133,9 @@ type Authentication struct {
 	idTokenValidator          *idtokenvalidator.IDTokenValidator
 	url                       *url.URL
 	retryStrategy             client.RetryOptions
+	oidcDiscovery             bool
+	oidcConfiguration         *OIDCConfiguration
+	oidcConfigurationMu       sync.Mutex
 }
 
 type manager struct {
@@ -171,7 +178,9 @@ func New(ctx context.Context, domain string, options ...Option) (*Authentication
 	)
 
 	a.common.authentication = a
+	a.CIBA = (*CIBA)(&a.common)
 	a.Database = (*Database)(&a.common)
+	a.Logout = (*Logout)(&a.common)
 	a.OAuth = (*OAuth)(&a.common)
 	a.Passwordless = (*Passwordless)(&a.common)
 
@@ -185,6 +194,27 @@ func New(ctx context.Context, domain string, options ...Option) (*Authentication
 		validatorOpts = append(validatorOpts, idtokenvalidator.WithClockTolerance(a.idTokenClockTolerance))
 	}
 
+	if a.oidcDiscovery {
+		oidcConfiguration, err := a.OIDCConfiguration(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !oidcConfiguration.SupportsIDTokenSigningAlg(a.idTokenSigningAlg) {
+			return nil, fmt.Errorf(
+				"ID token signing algorithm \"%s\" is not supported by the issuer; supported algorithms are %v",
+				a.idTokenSigningAlg,
+				oidcConfiguration.IDTokenSigningAlgValuesSupported,
+			)
+		}
+
+		validatorOpts = append(validatorOpts, idtokenvalidator.WithIssuer(oidcConfiguration.Issuer))
+
+		if oidcConfiguration.JWKSURI != "" {
+			validatorOpts = append(validatorOpts, idtokenvalidator.WithJWKSURL(oidcConfiguration.JWKSURI))
+		}
+	}
+
 	validator, err := idtokenvalidator.New(
 		ctx,
 		domain,
@@ -211,6 +241,6 @@ func New(ctx context.Context, domain string, options ...Option) (*Authentication
 // See: https://auth0.com/docs/api/authentication?http#get-user-info
 func (a *Authentication) UserInfo(ctx context.Context, accessToken string, opts ...RequestOption) (user *UserInfoResponse, err error) {
 	opts = append(opts, Header("Authorization", "Bearer "+accessToken))
-	err = a.Request(ctx, "GET", a.URI("userinfo"), nil, &user, opts...)
+	err = a.Request(ctx, "GET", a.userinfoEndpoint(), nil, &user, opts...)
 	return
 }
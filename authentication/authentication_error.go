@@ -25,9 +25,12 @@ func newError(response *http.Response) error {
 
 	// This can happen in case the error message structure changes.
 	// If that happens we still want to display the correct code.
+	// OAuth error responses only contain an error code, which we want to keep.
 	if apiError.Status() == 0 {
 		apiError.StatusCode = response.StatusCode
-		apiError.Err = http.StatusText(response.StatusCode)
+		if apiError.Err == "" {
+			apiError.Err = http.StatusText(response.StatusCode)
+		}
 	}
 
 	return apiError
@@ -51,6 +51,18 @@ func Test_newError(t *testing.T) {
 				Message:    "",
 			},
 		},
+		{
+			name: "it keeps the error code of an OAuth error response",
+			givenResponse: http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(strings.NewReader(`{"error":"authorization_pending","error_description":"The end-user authorization is pending"}`)),
+			},
+			expectedError: authenticationError{
+				StatusCode: 400,
+				Err:        "authorization_pending",
+				Message:    "The end-user authorization is pending",
+			},
+		},
 		{
 			name: "it will handle an invalid sign up response",
 			givenResponse: http.Response{
@@ -46,6 +46,15 @@ func WithIDTokenClockTolerance(clockTolerance time.Duration) Option {
 	}
 }
 
+// WithOIDCDiscovery configures the client to fetch the tenant's OIDC discovery document during `New`.
+// The endpoints, issuer and JWKS URL advertised in the document are then used instead of the default
+// Auth0 paths, and the configured ID token signing algorithm is checked against the supported ones.
+func WithOIDCDiscovery() Option {
+	return func(a *Authentication) {
+		a.oidcDiscovery = true
+	}
+}
+
 // WithClient configures to use the provided client for authentication and JWKS calls.
 func WithClient(client *http.Client) Option {
 	return func(a *Authentication) {
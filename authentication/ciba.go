@@ -0,0 +1,157 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0/authentication/ciba"
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+const (
+	cibaGrantType           = "urn:openid:params:grant-type:ciba"
+	defaultCIBAPollInterval = 5 * time.Second
+)
+
+// cibaSlowDownIncrement is the amount the polling interval is increased by when Auth0 responds with
+// `slow_down`, as defined by the specification.
+var cibaSlowDownIncrement = 5 * time.Second
+
+// CIBA exposes the Client-Initiated Backchannel Authentication flow.
+type CIBA manager
+
+// Initiate starts a Client-Initiated Backchannel Authentication flow, sending a push notification to
+// the user identified by the login hint. Use `Poll` or `WaitForToken` with the returned `auth_req_id` to
+// retrieve the tokens once the user has approved the request.
+//
+// See: https://auth0.com/docs/get-started/authentication-and-authorization-flow/client-initiated-backchannel-authentication-flow
+func (c *CIBA) Initiate(ctx context.Context, body ciba.Request, opts ...RequestOption) (r *ciba.Response, err error) {
+	if body.LoginHint.Subject == "" {
+		return nil, errors.New("login_hint subject is required")
+	}
+
+	if body.Scope == "" {
+		return nil, errors.New("scope is required")
+	}
+
+	if body.LoginHint.Format == "" {
+		body.LoginHint.Format = "iss_sub"
+	}
+
+	if body.LoginHint.Issuer == "" {
+		body.LoginHint.Issuer = c.authentication.issuer()
+	}
+
+	loginHint, err := json.Marshal(body.LoginHint)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{
+		"login_hint": []string{string(loginHint)},
+		"scope":      []string{body.Scope},
+	}
+
+	if body.BindingMessage != "" {
+		data.Set("binding_message", body.BindingMessage)
+	}
+
+	if body.Audience != "" {
+		data.Set("audience", body.Audience)
+	}
+
+	if body.RequestedExpiry != 0 {
+		data.Set("requested_expiry", strconv.FormatInt(int64(body.RequestedExpiry.Seconds()), 10))
+	}
+
+	for k, v := range body.ExtraParameters {
+		data.Set(k, v)
+	}
+
+	err = (*OAuth)(c).addClientAuthentication(body.ClientAuthentication, data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.authentication.Request(ctx, "POST", c.authentication.backchannelAuthenticationEndpoint(), data, &r, opts...)
+	return
+}
+
+// Poll makes a single request for the tokens of a Client-Initiated Backchannel Authentication flow.
+//
+// If the user has not yet approved the request an error wrapping `ciba.ErrAuthorizationPending` is
+// returned, and if polling too frequently an error wrapping `ciba.ErrSlowDown` is returned. Any ID token
+// returned is validated.
+//
+// See: https://auth0.com/docs/get-started/authentication-and-authorization-flow/client-initiated-backchannel-authentication-flow/user-authentication-with-ciba
+func (c *CIBA) Poll(ctx context.Context, body ciba.PollRequest, validationOptions oauth.IDTokenValidationOptions, opts ...RequestOption) (t *oauth.TokenSet, err error) {
+	if body.AuthReqID == "" {
+		return nil, errors.New("auth_req_id is required")
+	}
+
+	data := url.Values{
+		"auth_req_id": []string{body.AuthReqID},
+	}
+
+	for k, v := range body.ExtraParameters {
+		data.Set(k, v)
+	}
+
+	err = (*OAuth)(c).addClientAuthentication(body.ClientAuthentication, data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err = (*OAuth)(c).LoginWithGrant(ctx, cibaGrantType, data, validationOptions, opts...)
+
+	var authErr *authenticationError
+	if errors.As(err, &authErr) {
+		switch authErr.Err {
+		case "authorization_pending":
+			return nil, fmt.Errorf("%w: %s", ciba.ErrAuthorizationPending, authErr.Message)
+		case "slow_down":
+			return nil, fmt.Errorf("%w: %s", ciba.ErrSlowDown, authErr.Message)
+		}
+	}
+
+	return
+}
+
+// WaitForToken polls for the tokens of a Client-Initiated Backchannel Authentication flow until the user
+// approves or rejects the request, the request expires or the context is done.
+//
+// The polling interval is increased as requested by Auth0 when it responds with `slow_down`.
+func (c *CIBA) WaitForToken(ctx context.Context, body ciba.PollRequest, validationOptions oauth.IDTokenValidationOptions, opts ...RequestOption) (*oauth.TokenSet, error) {
+	interval := body.Interval
+	if interval == 0 {
+		interval = defaultCIBAPollInterval
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		t, err := c.Poll(ctx, body, validationOptions, opts...)
+		switch {
+		case err == nil:
+			return t, nil
+		case errors.Is(err, ciba.ErrSlowDown):
+			interval += cibaSlowDownIncrement
+		case !errors.Is(err, ciba.ErrAuthorizationPending):
+			return nil, err
+		}
+
+		timer.Reset(interval)
+	}
+}
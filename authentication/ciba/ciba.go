@@ -0,0 +1,79 @@
+package ciba
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+var (
+	// ErrAuthorizationPending is returned when polling before the user has approved or rejected the
+	// authentication request.
+	ErrAuthorizationPending = errors.New("authorization_pending")
+
+	// ErrSlowDown is returned when polling more frequently than allowed. The polling interval should be
+	// increased by 5 seconds.
+	ErrSlowDown = errors.New("slow_down")
+)
+
+// LoginHint identifies the user to authenticate. It is sent as a JSON encoded `login_hint` parameter.
+type LoginHint struct {
+	// The format of the login hint. Defaults to `iss_sub`.
+	Format string `json:"format"`
+	// The issuer of the user's identity. Defaults to the tenant's issuer.
+	Issuer string `json:"iss"`
+	// The ID of the user to authenticate.
+	Subject string `json:"sub"`
+}
+
+// Request defines the request body for starting a Client-Initiated Backchannel Authentication flow.
+type Request struct {
+	oauth.ClientAuthentication
+	// The user to authenticate.
+	LoginHint LoginHint
+	// A human-readable message displayed on both the consumption and the authentication device, allowing
+	// the user to check that they are approving the right request.
+	BindingMessage string
+	// String value of the different scopes the application is asking for. Multiple scopes are separated
+	// with whitespace. Must include `openid`.
+	Scope string
+	// The unique identifier of the target API you want to access.
+	Audience string
+	// How long the authentication request is valid for. Uses the tenant default if not set.
+	RequestedExpiry time.Duration
+	// Extra parameters to be merged into the request body. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// Response defines the response of starting a Client-Initiated Backchannel Authentication flow.
+type Response struct {
+	// The ID of the authentication request, used when polling for tokens.
+	AuthReqID string `json:"auth_req_id"`
+	// The duration in seconds that the authentication request is valid for.
+	ExpiresIn int64 `json:"expires_in"`
+	// The minimum number of seconds to wait between polling requests.
+	Interval int64 `json:"interval"`
+}
+
+// PollRequest defines the request body for retrieving the tokens of a Client-Initiated Backchannel
+// Authentication flow.
+type PollRequest struct {
+	oauth.ClientAuthentication
+	// The ID of the authentication request returned when starting the flow.
+	AuthReqID string
+	// How long to wait between polling requests. Defaults to 5 seconds.
+	Interval time.Duration
+	// Extra parameters to be merged into the request body. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// PollRequest returns a PollRequest for the authentication request, using the polling interval
+// returned by Auth0.
+func (r *Response) PollRequest(clientAuthentication oauth.ClientAuthentication) PollRequest {
+	return PollRequest{
+		ClientAuthentication: clientAuthentication,
+		AuthReqID:            r.AuthReqID,
+		Interval:             time.Duration(r.Interval) * time.Second,
+	}
+}
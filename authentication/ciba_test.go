@@ -0,0 +1,168 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0/authentication/ciba"
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+func TestCIBAInitiate(t *testing.T) {
+	t.Run("Should require a login hint subject and scope", func(t *testing.T) {
+		_, err := authAPI.CIBA.Initiate(context.Background(), ciba.Request{Scope: "openid"})
+		assert.ErrorContains(t, err, "login_hint subject is required")
+
+		_, err = authAPI.CIBA.Initiate(context.Background(), ciba.Request{
+			LoginHint: ciba.LoginHint{Subject: "auth0|123"},
+		})
+		assert.ErrorContains(t, err, "scope is required")
+	})
+
+	t.Run("Should start a backchannel authentication request", func(t *testing.T) {
+		var form url.Values
+		api, issuer := withCIBAServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/bc-authorize", r.URL.Path)
+			require.NoError(t, r.ParseForm())
+			form = r.PostForm
+			fmt.Fprint(w, `{"auth_req_id":"test-auth-req-id","expires_in":300,"interval":5}`)
+		})
+
+		response, err := api.CIBA.Initiate(context.Background(), ciba.Request{
+			LoginHint:       ciba.LoginHint{Subject: "auth0|123"},
+			BindingMessage:  "Confirm call 1234",
+			Scope:           "openid profile",
+			Audience:        "test-audience",
+			RequestedExpiry: 2 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "test-auth-req-id", response.AuthReqID)
+		assert.Equal(t, int64(300), response.ExpiresIn)
+		assert.Equal(t, int64(5), response.Interval)
+
+		var loginHint map[string]string
+		require.NoError(t, json.Unmarshal([]byte(form.Get("login_hint")), &loginHint))
+		assert.Equal(t, map[string]string{"format": "iss_sub", "iss": issuer, "sub": "auth0|123"}, loginHint)
+		assert.Equal(t, "Confirm call 1234", form.Get("binding_message"))
+		assert.Equal(t, "openid profile", form.Get("scope"))
+		assert.Equal(t, "test-audience", form.Get("audience"))
+		assert.Equal(t, "120", form.Get("requested_expiry"))
+		assert.Equal(t, "test-client-id", form.Get("client_id"))
+		assert.Equal(t, "test-client-secret", form.Get("client_secret"))
+	})
+}
+
+func TestCIBAWaitForToken(t *testing.T) {
+	previousIncrement := cibaSlowDownIncrement
+	cibaSlowDownIncrement = 10 * time.Millisecond
+	t.Cleanup(func() {
+		cibaSlowDownIncrement = previousIncrement
+	})
+
+	t.Run("Should poll until the user approves the request", func(t *testing.T) {
+		var grantTypes []string
+		responses := []string{
+			`{"error":"authorization_pending","error_description":"The end-user authorization is pending"}`,
+			`{"error":"slow_down","error_description":"You are polling faster than allowed"}`,
+			`{"error":"authorization_pending","error_description":"The end-user authorization is pending"}`,
+		}
+		api, _ := withCIBAServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "test-auth-req-id", r.PostForm.Get("auth_req_id"))
+			grantTypes = append(grantTypes, r.PostForm.Get("grant_type"))
+
+			if len(responses) > 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, responses[0])
+				responses = responses[1:]
+				return
+			}
+
+			fmt.Fprint(w, `{"access_token":"test-access-token","token_type":"Bearer","expires_in":86400}`)
+		})
+
+		response := &ciba.Response{AuthReqID: "test-auth-req-id"}
+		pollRequest := response.PollRequest(oauth.ClientAuthentication{})
+		pollRequest.Interval = time.Millisecond
+
+		tokenSet, err := api.CIBA.WaitForToken(context.Background(), pollRequest, oauth.IDTokenValidationOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "test-access-token", tokenSet.AccessToken)
+		assert.Len(t, grantTypes, 4)
+		assert.Equal(t, "urn:openid:params:grant-type:ciba", grantTypes[0])
+	})
+
+	t.Run("Should return pending errors when polling once", func(t *testing.T) {
+		api, _ := withCIBAServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"authorization_pending","error_description":"The end-user authorization is pending"}`)
+		})
+
+		_, err := api.CIBA.Poll(context.Background(), ciba.PollRequest{AuthReqID: "test-auth-req-id"}, oauth.IDTokenValidationOptions{})
+		assert.ErrorIs(t, err, ciba.ErrAuthorizationPending)
+	})
+
+	t.Run("Should stop when the user rejects the request", func(t *testing.T) {
+		api, _ := withCIBAServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":"access_denied","error_description":"The end-user denied the authorization request"}`)
+		})
+
+		_, err := api.CIBA.WaitForToken(context.Background(), ciba.PollRequest{
+			AuthReqID: "test-auth-req-id",
+			Interval:  time.Millisecond,
+		}, oauth.IDTokenValidationOptions{})
+		assert.ErrorContains(t, err, "access_denied")
+		assert.NotErrorIs(t, err, ciba.ErrAuthorizationPending)
+	})
+
+	t.Run("Should stop when the context is done", func(t *testing.T) {
+		api, _ := withCIBAServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"authorization_pending","error_description":"The end-user authorization is pending"}`)
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := api.CIBA.WaitForToken(ctx, ciba.PollRequest{
+			AuthReqID: "test-auth-req-id",
+			Interval:  time.Millisecond,
+		}, oauth.IDTokenValidationOptions{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func withCIBAServer(t *testing.T, handler http.HandlerFunc) (*Authentication, string) {
+	t.Helper()
+
+	s := httptest.NewTLSServer(handler)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	URL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	api, err := New(
+		context.Background(),
+		URL.Host,
+		WithClient(s.Client()),
+		WithClientID("test-client-id"),
+		WithClientSecret("test-client-secret"),
+		WithIDTokenSigningAlg("HS256"),
+		WithNoRetries(),
+	)
+	require.NoError(t, err)
+
+	return api, s.URL + "/"
+}
@@ -0,0 +1,230 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0/authentication/logout"
+)
+
+const defaultReplayWindow = 10 * time.Minute
+
+// Logout exposes logging out of Auth0 sessions.
+type Logout manager
+
+// URL returns the URL of the Auth0 logout endpoint for the provided parameters. Redirect the user to
+// this URL to clear their Auth0 session.
+//
+// See: https://auth0.com/docs/api/authentication#auth0-logout
+func (l *Logout) URL(params logout.Request) string {
+	query := url.Values{}
+
+	clientID := params.ClientID
+	if clientID == "" {
+		clientID = l.authentication.clientID
+	}
+
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+
+	if params.ReturnTo != "" {
+		query.Set("returnTo", params.ReturnTo)
+	}
+
+	for k, v := range params.ExtraParameters {
+		query.Set(k, v)
+	}
+
+	return buildLogoutURL(l.authentication.URI("v2", "logout"), query, params.Federated)
+}
+
+// OIDCURL returns the URL of the OIDC RP-Initiated logout endpoint for the provided parameters.
+//
+// See: https://auth0.com/docs/api/authentication#oidc-logout
+func (l *Logout) OIDCURL(params logout.OIDCRequest) string {
+	query := url.Values{}
+
+	clientID := params.ClientID
+	if clientID == "" {
+		clientID = l.authentication.clientID
+	}
+
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+
+	if params.IDTokenHint != "" {
+		query.Set("id_token_hint", params.IDTokenHint)
+	}
+
+	if params.PostLogoutRedirectURI != "" {
+		query.Set("post_logout_redirect_uri", params.PostLogoutRedirectURI)
+	}
+
+	if params.LogoutHint != "" {
+		query.Set("logout_hint", params.LogoutHint)
+	}
+
+	if params.State != "" {
+		query.Set("state", params.State)
+	}
+
+	if params.UILocales != "" {
+		query.Set("ui_locales", params.UILocales)
+	}
+
+	for k, v := range params.ExtraParameters {
+		query.Set(k, v)
+	}
+
+	return buildLogoutURL(l.authentication.endSessionEndpoint(), query, params.Federated)
+}
+
+// ValidateBackchannelLogoutToken validates a logout token sent to an application's OIDC Back-Channel
+// Logout URL and returns its claims.
+//
+// The token's signature, issuer, audience, `events` claim and `sub`/`sid` claims are verified. If a
+// ReplayStore is provided, tokens whose `jti` has already been seen are rejected. The `jti` is recorded
+// as soon as the token is validated, so it should be passed to the store's Forget if the logout then
+// fails, for the token to be accepted when Auth0 sends it again.
+//
+// See: https://auth0.com/docs/authenticate/login/logout/back-channel-logout
+func (l *Logout) ValidateBackchannelLogoutToken(
+	ctx context.Context,
+	logoutToken string,
+	opts logout.BackchannelLogoutValidationOptions,
+) (*logout.BackchannelLogoutToken, error) {
+	t, err := l.authentication.idTokenValidator.ValidateLogoutToken(ctx, logoutToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &logout.BackchannelLogoutToken{
+		Issuer:     t.Issuer(),
+		Audience:   t.Audience(),
+		Subject:    t.Subject(),
+		JTI:        t.JwtID(),
+		IssuedAt:   t.IssuedAt(),
+		Expiration: t.Expiration(),
+	}
+
+	if sid, ok := t.Get("sid"); ok {
+		sidString, ok := sid.(string)
+		if !ok {
+			return nil, errors.New("sid claim must be a string in the logout token")
+		}
+		token.SessionID = sidString
+	}
+
+	if events, ok := t.Get("events"); ok {
+		token.Events, _ = events.(map[string]interface{})
+	}
+
+	if opts.ReplayStore != nil {
+		expiresAt := token.Expiration
+		if expiresAt.IsZero() {
+			replayWindow := opts.ReplayWindow
+			if replayWindow == 0 {
+				replayWindow = defaultReplayWindow
+			}
+			expiresAt = token.IssuedAt.Add(replayWindow)
+		}
+
+		seen, err := opts.ReplayStore.Seen(ctx, token.JTI, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the logout token for replays: %w", err)
+		}
+
+		if seen {
+			return nil, fmt.Errorf("logout token with jti \"%s\" has already been used", token.JTI)
+		}
+	}
+
+	return token, nil
+}
+
+// BackchannelLogoutHandler returns an http.Handler to be mounted at an application's OIDC Back-Channel
+// Logout URL.
+//
+// The handler validates the `logout_token` posted by Auth0 using ValidateBackchannelLogoutToken and
+// calls onLogout with its claims so that the application can end the matching sessions. Invalid tokens
+// result in a 400 response, and errors returned by onLogout result in a 501 response as described in
+// the specification. Tokens whose logout failed are removed from the ReplayStore, so that they are
+// accepted again when retried.
+//
+// See: https://openid.net/specs/openid-connect-backchannel-1_0.html#BCResponse
+func (l *Logout) BackchannelLogoutHandler(
+	opts logout.BackchannelLogoutValidationOptions,
+	onLogout func(ctx context.Context, token *logout.BackchannelLogoutToken) error,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeBackchannelLogoutError(w, http.StatusMethodNotAllowed, "invalid_request", "method must be POST")
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			writeBackchannelLogoutError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+
+		logoutToken := r.PostForm.Get("logout_token")
+		if logoutToken == "" {
+			writeBackchannelLogoutError(w, http.StatusBadRequest, "invalid_request", "logout_token is required")
+			return
+		}
+
+		token, err := l.ValidateBackchannelLogoutToken(r.Context(), logoutToken, opts)
+		if err != nil {
+			writeBackchannelLogoutError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+
+		if err := onLogout(r.Context(), token); err != nil {
+			if opts.ReplayStore != nil {
+				if forgetErr := opts.ReplayStore.Forget(r.Context(), token.JTI); forgetErr != nil {
+					err = fmt.Errorf("%w (and failed to forget the logout token: %v)", err, forgetErr)
+				}
+			}
+			writeBackchannelLogoutError(w, http.StatusNotImplemented, "logout_failed", err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func buildLogoutURL(uri string, query url.Values, federated bool) string {
+	rawQuery := query.Encode()
+
+	if federated {
+		if rawQuery != "" {
+			rawQuery += "&"
+		}
+		rawQuery += "federated"
+	}
+
+	if rawQuery == "" {
+		return uri
+	}
+
+	return uri + "?" + rawQuery
+}
+
+func writeBackchannelLogoutError(w http.ResponseWriter, status int, code, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
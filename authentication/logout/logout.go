@@ -0,0 +1,122 @@
+package logout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Request defines the parameters used to build an Auth0 logout URL.
+type Request struct {
+	// The client ID of the application. Defaults to the client ID configured on the Authentication client.
+	ClientID string
+	// URL to redirect the user to after the logout. It must be in the Allowed Logout URLs of the
+	// application or the tenant.
+	ReturnTo string
+	// Whether to also log the user out of the identity provider they used to log in.
+	Federated bool
+	// Extra parameters to be merged into the URL. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// OIDCRequest defines the parameters used to build an OIDC RP-Initiated logout URL.
+type OIDCRequest struct {
+	// The client ID of the application. Defaults to the client ID configured on the Authentication client.
+	ClientID string
+	// A previously issued ID token for the user, used as a hint about the user's current session.
+	IDTokenHint string
+	// URL to redirect the user to after the logout. It must be in the Allowed Logout URLs of the
+	// application or the tenant.
+	PostLogoutRedirectURI string
+	// A hint about the user that is logging out, such as the session ID (`sid`).
+	LogoutHint string
+	// An opaque value that is returned to the PostLogoutRedirectURI.
+	State string
+	// Space-delimited list of locales used for the logout prompt, ordered by preference.
+	UILocales string
+	// Whether to also log the user out of the identity provider they used to log in.
+	Federated bool
+	// Extra parameters to be merged into the URL. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// BackchannelLogoutToken defines the claims of a validated OIDC Back-Channel Logout token.
+type BackchannelLogoutToken struct {
+	// The issuer of the logout token.
+	Issuer string
+	// The audiences of the logout token.
+	Audience []string
+	// The user whose session is being logged out. Either Subject or SessionID is set.
+	Subject string
+	// The session being logged out. Either Subject or SessionID is set.
+	SessionID string
+	// The unique identifier of the logout token.
+	JTI string
+	// The time the logout token was issued at.
+	IssuedAt time.Time
+	// The time the logout token expires at, if set.
+	Expiration time.Time
+	// The events claim of the logout token.
+	Events map[string]interface{}
+}
+
+// BackchannelLogoutValidationOptions defines the options used when validating a logout token.
+type BackchannelLogoutValidationOptions struct {
+	// ReplayStore is used to reject logout tokens that have already been processed. Replays are not
+	// checked if it is nil.
+	ReplayStore ReplayStore
+	// How long to remember a logout token's ID when the token does not contain an `exp` claim.
+	// Defaults to 10 minutes.
+	ReplayWindow time.Duration
+}
+
+// ReplayStore records the IDs of processed logout tokens so that replayed tokens can be rejected.
+//
+// Implementations must be safe for concurrent use. When running several instances of an application
+// the store should be shared between them.
+type ReplayStore interface {
+	// Seen records the token ID until expiresAt and reports whether it had already been recorded.
+	Seen(ctx context.Context, jti string, expiresAt time.Time) (bool, error)
+	// Forget removes the token ID, so that the token can be processed again after a failure.
+	Forget(ctx context.Context, jti string) error
+}
+
+// MemoryReplayStore is an in-memory ReplayStore suitable for single instance applications.
+type MemoryReplayStore struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewMemoryReplayStore returns an empty MemoryReplayStore.
+func NewMemoryReplayStore() *MemoryReplayStore {
+	return &MemoryReplayStore{seen: map[string]time.Time{}}
+}
+
+// Seen records the token ID until expiresAt and reports whether it had already been recorded.
+func (s *MemoryReplayStore) Seen(_ context.Context, jti string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, expiry := range s.seen {
+		if now.After(expiry) {
+			delete(s.seen, id)
+		}
+	}
+
+	if _, ok := s.seen[jti]; ok {
+		return true, nil
+	}
+
+	s.seen[jti] = expiresAt
+	return false, nil
+}
+
+// Forget removes the token ID, so that the token can be processed again after a failure.
+func (s *MemoryReplayStore) Forget(_ context.Context, jti string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.seen, jti)
+	return nil
+}
@@ -0,0 +1,324 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0/authentication/logout"
+)
+
+func TestLogoutURL(t *testing.T) {
+	api, err := New(
+		context.Background(),
+		"example.auth0.com",
+		WithClientID("test-client-id"),
+		WithIDTokenSigningAlg("HS256"),
+	)
+	require.NoError(t, err)
+
+	t.Run("Should build an Auth0 logout URL", func(t *testing.T) {
+		logoutURL := api.Logout.URL(logout.Request{
+			ReturnTo: "https://app.example.com/goodbye",
+		})
+		assert.Equal(
+			t,
+			"https://example.auth0.com/v2/logout?client_id=test-client-id&returnTo=https%3A%2F%2Fapp.example.com%2Fgoodbye",
+			logoutURL,
+		)
+	})
+
+	t.Run("Should support federated logout and overriding the client ID", func(t *testing.T) {
+		logoutURL := api.Logout.URL(logout.Request{
+			ClientID:  "other-client-id",
+			Federated: true,
+		})
+		assert.Equal(t, "https://example.auth0.com/v2/logout?client_id=other-client-id&federated", logoutURL)
+	})
+
+	t.Run("Should build an OIDC logout URL", func(t *testing.T) {
+		logoutURL := api.Logout.OIDCURL(logout.OIDCRequest{
+			IDTokenHint:           "test-id-token",
+			PostLogoutRedirectURI: "https://app.example.com/goodbye",
+			LogoutHint:            "test-sid",
+			State:                 "test-state",
+			UILocales:             "fr-CA en",
+			ExtraParameters: map[string]string{
+				"extra": "value",
+			},
+		})
+
+		u, err := url.Parse(logoutURL)
+		require.NoError(t, err)
+		assert.Equal(t, "/oidc/logout", u.Path)
+		assert.Equal(t, url.Values{
+			"client_id":                []string{"test-client-id"},
+			"id_token_hint":            []string{"test-id-token"},
+			"post_logout_redirect_uri": []string{"https://app.example.com/goodbye"},
+			"logout_hint":              []string{"test-sid"},
+			"state":                    []string{"test-state"},
+			"ui_locales":               []string{"fr-CA en"},
+			"extra":                    []string{"value"},
+		}, u.Query())
+	})
+}
+
+func TestBackchannelLogout(t *testing.T) {
+	api, issuer, _ := withBackchannelLogoutServer(t, "RS256")
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": issuer,
+			"aud": "test-client-id",
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(2 * time.Minute).Unix(),
+			"jti": fmt.Sprintf("jti-%d", time.Now().UnixNano()),
+			"sid": "test-sid",
+			"sub": "auth0|123",
+			"events": map[string]interface{}{
+				"http://schemas.openid.net/event/backchannel-logout": map[string]interface{}{},
+			},
+		}
+	}
+
+	t.Run("Should validate a logout token", func(t *testing.T) {
+		token, err := api.Logout.ValidateBackchannelLogoutToken(
+			context.Background(),
+			givenALogoutToken(t, validClaims()),
+			logout.BackchannelLogoutValidationOptions{},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "test-sid", token.SessionID)
+		assert.Equal(t, "auth0|123", token.Subject)
+		assert.Equal(t, issuer, token.Issuer)
+	})
+
+	t.Run("Should fetch the JWKS once when using HS256 ID tokens", func(t *testing.T) {
+		hsAPI, hsIssuer, jwksRequests := withBackchannelLogoutServer(t, "HS256")
+		assert.Zero(t, atomic.LoadInt32(jwksRequests))
+
+		for n := 0; n < 3; n++ {
+			claims := validClaims()
+			claims["iss"] = hsIssuer
+
+			_, err := hsAPI.Logout.ValidateBackchannelLogoutToken(
+				context.Background(),
+				givenALogoutToken(t, claims),
+				logout.BackchannelLogoutValidationOptions{},
+			)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(jwksRequests))
+	})
+
+	for name, testCase := range map[string]struct {
+		modify   func(claims map[string]interface{})
+		expected string
+	}{
+		"Should require the backchannel logout event": {
+			modify: func(claims map[string]interface{}) {
+				claims["events"] = map[string]interface{}{"other": map[string]interface{}{}}
+			},
+			expected: "events claim must contain",
+		},
+		"Should require the events claim": {
+			modify:   func(claims map[string]interface{}) { delete(claims, "events") },
+			expected: "events claim must be present",
+		},
+		"Should require a sub or sid": {
+			modify: func(claims map[string]interface{}) {
+				delete(claims, "sub")
+				delete(claims, "sid")
+			},
+			expected: "sub or sid claim must be present",
+		},
+		"Should reject a nonce": {
+			modify:   func(claims map[string]interface{}) { claims["nonce"] = "test-nonce" },
+			expected: "nonce claim must not be present",
+		},
+		"Should require a jti": {
+			modify:   func(claims map[string]interface{}) { delete(claims, "jti") },
+			expected: "jti claim must be a string present",
+		},
+		"Should validate the audience": {
+			modify:   func(claims map[string]interface{}) { claims["aud"] = "other-client-id" },
+			expected: `"aud" not satisfied`,
+		},
+		"Should validate the issuer": {
+			modify:   func(claims map[string]interface{}) { claims["iss"] = "https://other.auth0.com/" },
+			expected: `"iss" not satisfied`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			claims := validClaims()
+			testCase.modify(claims)
+
+			_, err := api.Logout.ValidateBackchannelLogoutToken(
+				context.Background(),
+				givenALogoutToken(t, claims),
+				logout.BackchannelLogoutValidationOptions{},
+			)
+			assert.ErrorContains(t, err, testCase.expected)
+		})
+	}
+
+	t.Run("Should reject replayed tokens", func(t *testing.T) {
+		opts := logout.BackchannelLogoutValidationOptions{
+			ReplayStore: logout.NewMemoryReplayStore(),
+		}
+		logoutToken := givenALogoutToken(t, validClaims())
+
+		_, err := api.Logout.ValidateBackchannelLogoutToken(context.Background(), logoutToken, opts)
+		require.NoError(t, err)
+
+		_, err = api.Logout.ValidateBackchannelLogoutToken(context.Background(), logoutToken, opts)
+		assert.ErrorContains(t, err, "has already been used")
+	})
+
+	t.Run("Should handle backchannel logout requests", func(t *testing.T) {
+		var loggedOut *logout.BackchannelLogoutToken
+		handler := api.Logout.BackchannelLogoutHandler(
+			logout.BackchannelLogoutValidationOptions{ReplayStore: logout.NewMemoryReplayStore()},
+			func(ctx context.Context, token *logout.BackchannelLogoutToken) error {
+				loggedOut = token
+				return nil
+			},
+		)
+
+		logoutToken := givenALogoutToken(t, validClaims())
+
+		response := postLogoutToken(handler, logoutToken)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "no-store", response.Header().Get("Cache-Control"))
+		require.NotNil(t, loggedOut)
+		assert.Equal(t, "test-sid", loggedOut.SessionID)
+
+		response = postLogoutToken(handler, logoutToken)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+
+		var body map[string]string
+		err := json.Unmarshal(response.Body.Bytes(), &body)
+		require.NoError(t, err)
+		assert.Equal(t, "invalid_request", body["error"])
+		assert.Contains(t, body["error_description"], "has already been used")
+	})
+
+	t.Run("Should return 501 when the logout fails and accept the retry", func(t *testing.T) {
+		failures := 1
+		var loggedOut int
+		handler := api.Logout.BackchannelLogoutHandler(
+			logout.BackchannelLogoutValidationOptions{ReplayStore: logout.NewMemoryReplayStore()},
+			func(ctx context.Context, token *logout.BackchannelLogoutToken) error {
+				if failures > 0 {
+					failures--
+					return errors.New("session store unavailable")
+				}
+				loggedOut++
+				return nil
+			},
+		)
+
+		logoutToken := givenALogoutToken(t, validClaims())
+
+		response := postLogoutToken(handler, logoutToken)
+		assert.Equal(t, http.StatusNotImplemented, response.Code)
+
+		response = postLogoutToken(handler, logoutToken)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, 1, loggedOut)
+
+		response = postLogoutToken(handler, logoutToken)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("Should require a logout token", func(t *testing.T) {
+		handler := api.Logout.BackchannelLogoutHandler(
+			logout.BackchannelLogoutValidationOptions{},
+			func(ctx context.Context, token *logout.BackchannelLogoutToken) error {
+				return nil
+			},
+		)
+
+		response := postLogoutToken(handler, "")
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func postLogoutToken(handler http.Handler, logoutToken string) *httptest.ResponseRecorder {
+	body := url.Values{"logout_token": []string{logoutToken}}.Encode()
+	request := httptest.NewRequest(http.MethodPost, "/backchannel-logout", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	return response
+}
+
+func withBackchannelLogoutServer(t *testing.T, idTokenSigningAlg string) (*Authentication, string, *int32) {
+	t.Helper()
+
+	publicKey, err := jwk.ParseKey([]byte(jwtPublicKey), jwk.WithPEM(true))
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Set(jwk.KeyIDKey, "test-kid"))
+	require.NoError(t, publicKey.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	var jwksRequests int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&jwksRequests, 1)
+		b, err := json.Marshal(publicKey)
+		require.NoError(t, err)
+		fmt.Fprintf(w, `{"keys": [%s]}`, b)
+	})
+	s := httptest.NewTLSServer(h)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	URL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	api, err := New(
+		context.Background(),
+		URL.Host,
+		WithClient(s.Client()),
+		WithClientID("test-client-id"),
+		WithClientSecret("test-client-secret"),
+		WithIDTokenSigningAlg(idTokenSigningAlg),
+	)
+	require.NoError(t, err)
+
+	return api, s.URL + "/", &jwksRequests
+}
+
+func givenALogoutToken(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+
+	privateKey, err := jwk.ParseKey([]byte(jwtPrivateKey), jwk.WithPEM(true))
+	require.NoError(t, err)
+	require.NoError(t, privateKey.Set(jwk.KeyIDKey, "test-kid"))
+
+	token := jwt.New()
+	for claim, value := range claims {
+		require.NoError(t, token.Set(claim, value))
+	}
+
+	b, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, privateKey))
+	require.NoError(t, err)
+
+	return string(b)
+}
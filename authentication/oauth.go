@@ -24,7 +24,7 @@ type OAuth manager
 func (o *OAuth) LoginWithGrant(ctx context.Context, grantType string, body url.Values, validationOptions oauth.IDTokenValidationOptions, opts ...RequestOption) (t *oauth.TokenSet, err error) {
 	body.Add("grant_type", grantType)
 
-	err = o.authentication.Request(ctx, "POST", o.authentication.URI("oauth", "token"), body, &t, opts...)
+	err = o.authentication.Request(ctx, "POST", o.authentication.tokenEndpoint(), body, &t, opts...)
 
 	if t != nil && t.IDToken != "" {
 		err = o.authentication.idTokenValidator.Validate(t.IDToken, idtokenvalidator.ValidationOptions{
@@ -150,6 +150,14 @@ func (o *OAuth) LoginWithClientCredentials(ctx context.Context, body oauth.Login
 		"audience": []string{body.Audience},
 	}
 
+	if body.Organization != "" {
+		data.Set("organization", body.Organization)
+	}
+
+	for k, v := range body.ExtraParameters {
+		data.Set(k, v)
+	}
+
 	err = o.addClientAuthentication(body.ClientAuthentication, data, true)
 
 	if err != nil {
@@ -182,6 +190,62 @@ func (o *OAuth) RefreshToken(ctx context.Context, body oauth.RefreshTokenRequest
 	return
 }
 
+// LoginWithTokenExchange performs the Token Exchange OAuth 2.0 grant type, exchanging a subject token
+// (and optionally an actor token) for Auth0 tokens.
+//
+// This is used both for the standard token types defined by RFC 8693 and for Auth0's Custom Token
+// Exchange, where the SubjectTokenType is the one configured in a Custom Token Exchange Profile.
+//
+// See: https://auth0.com/docs/authenticate/custom-token-exchange
+func (o *OAuth) LoginWithTokenExchange(ctx context.Context, body oauth.LoginWithTokenExchangeRequest, validationOptions oauth.IDTokenValidationOptions, opts ...RequestOption) (t *oauth.TokenSet, err error) {
+	if body.SubjectToken == "" || body.SubjectTokenType == "" {
+		return nil, errors.New("subject_token and subject_token_type are required")
+	}
+
+	if body.ActorToken != "" && body.ActorTokenType == "" {
+		return nil, errors.New("actor_token_type is required when actor_token is provided")
+	}
+
+	data := url.Values{
+		"subject_token":      []string{body.SubjectToken},
+		"subject_token_type": []string{body.SubjectTokenType},
+	}
+
+	if body.ActorToken != "" {
+		data.Set("actor_token", body.ActorToken)
+		data.Set("actor_token_type", body.ActorTokenType)
+	}
+
+	if body.RequestedTokenType != "" {
+		data.Set("requested_token_type", body.RequestedTokenType)
+	}
+
+	if body.Audience != "" {
+		data.Set("audience", body.Audience)
+	}
+
+	if body.Scope != "" {
+		data.Set("scope", body.Scope)
+	}
+
+	if body.Organization != "" {
+		data.Set("organization", body.Organization)
+	}
+
+	for k, v := range body.ExtraParameters {
+		data.Set(k, v)
+	}
+
+	err = o.addClientAuthentication(body.ClientAuthentication, data, false)
+
+	if err != nil {
+		return
+	}
+
+	t, err = o.LoginWithGrant(ctx, "urn:ietf:params:oauth:grant-type:token-exchange", data, validationOptions, opts...)
+	return
+}
+
 // RevokeRefreshToken is used to invalidate a refresh token if it has been compromised.
 //
 // The behaviour of this endpoint depends on the state of the **Refresh Token Revocation Deletes Grant** toggle.
@@ -200,7 +264,105 @@ func (o *OAuth) RevokeRefreshToken(ctx context.Context, body oauth.RevokeRefresh
 		body.ClientSecret = o.authentication.clientSecret
 	}
 
-	return o.authentication.Request(ctx, "POST", o.authentication.URI("oauth", "revoke"), body, nil, opts...)
+	return o.authentication.Request(ctx, "POST", o.authentication.revocationEndpoint(), body, nil, opts...)
+}
+
+// PushedAuthorization performs a Pushed Authorization Request, sending the authorization request
+// parameters directly to Auth0 and returning a `request_uri` that references them. Use `AuthorizeURL`
+// to build the URL to redirect the user to.
+//
+// When `UseSignedRequestObject` is set the parameters are sent as a JWT Secured Authorization Request
+// (JAR) signed with the key configured using `WithClientAssertion`.
+//
+// See: https://auth0.com/docs/get-started/authentication-and-authorization-flow/authorization-code-flow/authorization-code-flow-with-par
+func (o *OAuth) PushedAuthorization(ctx context.Context, body oauth.PushedAuthorizationRequest, opts ...RequestOption) (r *oauth.PushedAuthorizationRequestResponse, err error) {
+	if body.RedirectURI == "" {
+		return nil, errors.New("redirect_uri is required")
+	}
+
+	if body.ResponseType == "" {
+		body.ResponseType = "code"
+	}
+
+	params := url.Values{
+		"response_type": []string{body.ResponseType},
+		"redirect_uri":  []string{body.RedirectURI},
+	}
+
+	optionalParams := map[string]string{
+		"scope":                 body.Scope,
+		"audience":              body.Audience,
+		"state":                 body.State,
+		"nonce":                 body.Nonce,
+		"code_challenge":        body.CodeChallenge,
+		"code_challenge_method": body.CodeChallengeMethod,
+		"connection":            body.Connection,
+		"organization":          body.Organization,
+	}
+	for k, v := range optionalParams {
+		if v != "" {
+			params.Set(k, v)
+		}
+	}
+
+	for k, v := range body.ExtraParameters {
+		params.Set(k, v)
+	}
+
+	data := params
+
+	if body.UseSignedRequestObject {
+		if o.authentication.clientAssertionSigningKey == "" || o.authentication.clientAssertionSigningAlg == "" {
+			return nil, errors.New("a signing key must be configured using WithClientAssertion to use signed request objects")
+		}
+
+		clientID := body.ClientID
+		if clientID == "" {
+			clientID = o.authentication.clientID
+		}
+
+		params.Set("client_id", clientID)
+
+		requestObject, err := createRequestObject(
+			o.authentication.clientAssertionSigningAlg,
+			o.authentication.clientAssertionSigningKey,
+			clientID,
+			o.authentication.issuer(),
+			params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		data = url.Values{
+			"request": []string{requestObject},
+		}
+	}
+
+	err = o.addClientAuthentication(body.ClientAuthentication, data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.authentication.Request(ctx, "POST", o.authentication.pushedAuthorizationRequestEndpoint(), data, &r, opts...)
+	return
+}
+
+// AuthorizeURL returns the URL of the authorize endpoint referencing an authorization request pushed
+// using `PushedAuthorization`. If clientID is empty the client ID configured on the client is used.
+//
+// See: https://auth0.com/docs/get-started/authentication-and-authorization-flow/authorization-code-flow/authorization-code-flow-with-par
+func (o *OAuth) AuthorizeURL(clientID string, requestURI string) string {
+	if clientID == "" {
+		clientID = o.authentication.clientID
+	}
+
+	query := url.Values{
+		"client_id":   []string{clientID},
+		"request_uri": []string{requestURI},
+	}
+
+	return o.authentication.authorizationEndpoint() + "?" + query.Encode()
 }
 
 func (o *OAuth) addClientAuthentication(params oauth.ClientAuthentication, body url.Values, required bool) error {
@@ -256,16 +418,6 @@ func determineAlg(alg string) (jwa.SignatureAlgorithm, error) {
 }
 
 func createClientAssertion(clientAssertionSigningAlg, clientAssertionSigningKey, clientID, domain string) (string, error) {
-	alg, err := determineAlg(clientAssertionSigningAlg)
-	if err != nil {
-		return "", err
-	}
-
-	key, err := jwk.ParseKey([]byte(clientAssertionSigningKey), jwk.WithPEM(true))
-	if err != nil {
-		return "", err
-	}
-
 	token, err := jwt.NewBuilder().
 		IssuedAt(time.Now()).
 		Subject(clientID).
@@ -278,6 +430,46 @@ func createClientAssertion(clientAssertionSigningAlg, clientAssertionSigningKey,
 		return "", err
 	}
 
+	return signJWT(clientAssertionSigningAlg, clientAssertionSigningKey, token)
+}
+
+// createRequestObject creates a signed request object containing the authorization request parameters.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc9101
+func createRequestObject(signingAlg, signingKey, clientID, issuer string, params url.Values) (string, error) {
+	now := time.Now()
+
+	builder := jwt.NewBuilder().
+		IssuedAt(now).
+		NotBefore(now).
+		Issuer(clientID).
+		JwtID(uuid.New().String()).
+		Audience([]string{issuer}).
+		Expiration(now.Add(2 * time.Minute))
+
+	for k := range params {
+		builder.Claim(k, params.Get(k))
+	}
+
+	token, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+
+	return signJWT(signingAlg, signingKey, token)
+}
+
+func signJWT(signingAlg, signingKey string, token jwt.Token) (string, error) {
+	alg, err := determineAlg(signingAlg)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := jwk.ParseKey([]byte(signingKey), jwk.WithPEM(true))
+	if err != nil {
+		return "", err
+	}
+
 	b, err := jwt.Sign(token, jwt.WithKey(alg, key))
 	if err != nil {
 		return "", err
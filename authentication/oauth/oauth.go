@@ -1,6 +1,9 @@
 package oauth
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // ClientAuthentication defines the authentication options that can be overridden per request.
 type ClientAuthentication struct {
@@ -32,6 +35,8 @@ type TokenSet struct {
 	Scope string `json:"scope,omitempty"`
 	// The type of the access token.
 	TokenType string `json:"token_type,omitempty"`
+	// The type of the issued token, only returned by the token exchange grant.
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
 }
 
 // LoginWithPasswordRequest defines the request body for logging in with the Password grant.
@@ -81,6 +86,46 @@ type LoginWithClientCredentialsRequest struct {
 	ClientAuthentication
 	// The unique identifier of the target API you want to access.
 	Audience string
+	// The ID or name of the organization the access token should be issued for. The client must be
+	// granted access to the API for the organization.
+	Organization string
+	// Extra parameters to be merged into the request body. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// Token type identifiers defined by RFC 8693 for use as the SubjectTokenType, ActorTokenType and
+// RequestedTokenType of a LoginWithTokenExchangeRequest.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc8693#section-3
+const (
+	TokenTypeAccessToken  = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIDToken      = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeJWT          = "urn:ietf:params:oauth:token-type:jwt"
+	TokenTypeSAML1        = "urn:ietf:params:oauth:token-type:saml1"
+	TokenTypeSAML2        = "urn:ietf:params:oauth:token-type:saml2"
+)
+
+// LoginWithTokenExchangeRequest defines the request body for logging in with the Token Exchange grant.
+type LoginWithTokenExchangeRequest struct {
+	ClientAuthentication
+	// The token that represents the identity of the party on behalf of whom the request is being made.
+	SubjectToken string
+	// The type of the SubjectToken. Either one of the `TokenType` constants or, when using Custom Token
+	// Exchange, the token type configured in the Custom Token Exchange Profile.
+	SubjectTokenType string
+	// The token that represents the identity of the acting party.
+	ActorToken string
+	// The type of the ActorToken. Required when ActorToken is set.
+	ActorTokenType string
+	// The type of token being requested.
+	RequestedTokenType string
+	// The unique identifier of the target API you want to access.
+	Audience string
+	// String value of the different scopes the application is asking for. Multiple scopes are separated with whitespace.
+	Scope string
+	// The organization or organization ID to log the user in to.
+	Organization string
 	// Extra parameters to be merged into the request body. Values set here will override any existing values.
 	ExtraParameters map[string]string
 }
@@ -106,9 +151,66 @@ type RevokeRefreshTokenRequest struct {
 	ExtraParameters map[string]string `json:"-"`
 }
 
+// PushedAuthorizationRequest defines the request body for a Pushed Authorization Request.
+type PushedAuthorizationRequest struct {
+	ClientAuthentication
+	// The response type the client expects. Defaults to `code`.
+	ResponseType string
+	// The URL to which Auth0 will redirect the browser after authorization has been granted by the user.
+	RedirectURI string
+	// String value of the different scopes the application is asking for. Multiple scopes are separated with whitespace.
+	Scope string
+	// The unique identifier of the target API you want to access.
+	Audience string
+	// An opaque value the application adds to the initial request that Auth0 includes when redirecting back.
+	State string
+	// A string value which will be included in the ID token response from Auth0, used to prevent token replay attacks.
+	Nonce string
+	// The PKCE code challenge.
+	CodeChallenge string
+	// The method used to generate the CodeChallenge, for example `S256`.
+	CodeChallengeMethod string
+	// The name of the connection to log the user in with.
+	Connection string
+	// The organization or organization ID to log the user in to.
+	Organization string
+	// Whether to send the parameters as a signed JWT request object (JAR) rather than as form parameters.
+	// Requires the client to be configured using `WithClientAssertion`.
+	UseSignedRequestObject bool
+	// Extra parameters to be merged into the request body. Values set here will override any existing values.
+	ExtraParameters map[string]string
+}
+
+// PushedAuthorizationRequestResponse defines the response of a Pushed Authorization Request.
+type PushedAuthorizationRequestResponse struct {
+	// The reference to the authorization request, used as the `request_uri` parameter of the authorize URL.
+	RequestURI string `json:"request_uri,omitempty"`
+	// The duration in seconds that the request URI is valid for.
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+}
+
 // IDTokenValidationOptions allows validating optional claims that might not always be in the ID token.
 type IDTokenValidationOptions struct {
 	MaxAge       time.Duration
 	Nonce        string
 	Organization string
 }
+
+// TokenSourceOptions defines the options used when creating an `oauth2.TokenSource` from a TokenSet.
+type TokenSourceOptions struct {
+	ClientAuthentication
+	// A space-delimited list of requested scope permissions to use when refreshing. If not sent, the
+	// original scopes will be used.
+	Scope string
+	// The time at which the TokenSet was issued, used together with `ExpiresIn` to compute when the
+	// access token expires. Defaults to the time the token source was created.
+	IssuedAt time.Time
+	// How long before the access token expires it should be refreshed. Defaults to 10 seconds, use a
+	// negative value to only refresh the access token once it has expired.
+	ExpiryDelta time.Duration
+	// Called with the new TokenSet whenever a refresh returns a rotated refresh token. Use this to
+	// persist the new refresh token, as the previous one may no longer be usable.
+	OnRefreshTokenRotated func(ctx context.Context, t *TokenSet) error
+	// Options used to validate the ID token returned when refreshing.
+	IDTokenValidationOptions IDTokenValidationOptions
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -179,6 +180,25 @@ func TestLoginWithClientCredentials(t *testing.T) {
 		assert.Equal(t, "Bearer", tokenSet.TokenType)
 	})
 
+	t.Run("Should support issuing tokens for an organization", func(t *testing.T) {
+		var form url.Values
+		api := withTokenExchangeServer(t, &form)
+
+		tokenSet, err := api.OAuth.LoginWithClientCredentials(context.Background(), oauth.LoginWithClientCredentialsRequest{
+			ClientAuthentication: oauth.ClientAuthentication{
+				ClientSecret: "test-client-secret",
+			},
+			Audience:     "test-audience",
+			Organization: "org_123",
+		}, oauth.IDTokenValidationOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test-access-token", tokenSet.AccessToken)
+		assert.Equal(t, "client_credentials", form.Get("grant_type"))
+		assert.Equal(t, "test-audience", form.Get("audience"))
+		assert.Equal(t, "org_123", form.Get("organization"))
+	})
+
 	t.Run("Should allow overriding clientid", func(t *testing.T) {
 		configureHTTPTestRecordings(t)
 
@@ -316,6 +336,240 @@ func TestRevokeRefreshToken(t *testing.T) {
 	})
 }
 
+func TestLoginWithTokenExchange(t *testing.T) {
+	t.Run("Should require a subject token and type", func(t *testing.T) {
+		_, err := authAPI.OAuth.LoginWithTokenExchange(context.Background(), oauth.LoginWithTokenExchangeRequest{
+			SubjectToken: "test-subject-token",
+		}, oauth.IDTokenValidationOptions{})
+
+		assert.ErrorContains(t, err, "subject_token and subject_token_type are required")
+	})
+
+	t.Run("Should require an actor token type with an actor token", func(t *testing.T) {
+		_, err := authAPI.OAuth.LoginWithTokenExchange(context.Background(), oauth.LoginWithTokenExchangeRequest{
+			SubjectToken:     "test-subject-token",
+			SubjectTokenType: oauth.TokenTypeAccessToken,
+			ActorToken:       "test-actor-token",
+		}, oauth.IDTokenValidationOptions{})
+
+		assert.ErrorContains(t, err, "actor_token_type is required when actor_token is provided")
+	})
+
+	t.Run("Should exchange tokens", func(t *testing.T) {
+		var form url.Values
+		api := withTokenExchangeServer(t, &form, WithClientSecret("test-client-secret"))
+
+		tokenSet, err := api.OAuth.LoginWithTokenExchange(context.Background(), oauth.LoginWithTokenExchangeRequest{
+			SubjectToken:       "test-subject-token",
+			SubjectTokenType:   oauth.TokenTypeAccessToken,
+			ActorToken:         "test-actor-token",
+			ActorTokenType:     oauth.TokenTypeJWT,
+			RequestedTokenType: oauth.TokenTypeAccessToken,
+			Audience:           "test-audience",
+			Scope:              "read:orders",
+			ExtraParameters: map[string]string{
+				"extra": "value",
+			},
+		}, oauth.IDTokenValidationOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "test-access-token", tokenSet.AccessToken)
+		assert.Equal(t, oauth.TokenTypeAccessToken, tokenSet.IssuedTokenType)
+		assert.Equal(t, url.Values{
+			"grant_type":           []string{"urn:ietf:params:oauth:grant-type:token-exchange"},
+			"client_id":            []string{"test-client-id"},
+			"client_secret":        []string{"test-client-secret"},
+			"subject_token":        []string{"test-subject-token"},
+			"subject_token_type":   []string{oauth.TokenTypeAccessToken},
+			"actor_token":          []string{"test-actor-token"},
+			"actor_token_type":     []string{oauth.TokenTypeJWT},
+			"requested_token_type": []string{oauth.TokenTypeAccessToken},
+			"audience":             []string{"test-audience"},
+			"scope":                []string{"read:orders"},
+			"extra":                []string{"value"},
+		}, form)
+	})
+
+	t.Run("Should support custom token exchange with private key jwt auth", func(t *testing.T) {
+		var form url.Values
+		api := withTokenExchangeServer(t, &form, WithClientAssertion(jwtPrivateKey, "RS256"))
+
+		_, err := api.OAuth.LoginWithTokenExchange(context.Background(), oauth.LoginWithTokenExchangeRequest{
+			SubjectToken:     "test-external-token",
+			SubjectTokenType: "urn:acme:legacy-token",
+			Organization:     "org_123",
+		}, oauth.IDTokenValidationOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "urn:acme:legacy-token", form.Get("subject_token_type"))
+		assert.Equal(t, "org_123", form.Get("organization"))
+		assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", form.Get("client_assertion_type"))
+		assert.NotEmpty(t, form.Get("client_assertion"))
+		assert.Empty(t, form.Get("client_secret"))
+	})
+}
+
+func withTokenExchangeServer(t *testing.T, form *url.Values, opts ...Option) *Authentication {
+	t.Helper()
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		require.NoError(t, err)
+		*form = r.PostForm
+
+		fmt.Fprint(w, `{
+			"access_token": "test-access-token",
+			"issued_token_type": "urn:ietf:params:oauth:token-type:access_token",
+			"token_type": "Bearer",
+			"expires_in": 86400
+		}`)
+	})
+	s := httptest.NewTLSServer(h)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	URL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	api, err := New(
+		context.Background(),
+		URL.Host,
+		append([]Option{
+			WithClient(s.Client()),
+			WithClientID("test-client-id"),
+			WithIDTokenSigningAlg("HS256"),
+		}, opts...)...,
+	)
+	require.NoError(t, err)
+
+	return api
+}
+
+func TestPushedAuthorization(t *testing.T) {
+	t.Run("Should require a redirect uri", func(t *testing.T) {
+		_, err := authAPI.OAuth.PushedAuthorization(context.Background(), oauth.PushedAuthorizationRequest{})
+
+		assert.ErrorContains(t, err, "redirect_uri is required")
+	})
+
+	t.Run("Should require a signing key for signed request objects", func(t *testing.T) {
+		_, err := authAPI.OAuth.PushedAuthorization(context.Background(), oauth.PushedAuthorizationRequest{
+			RedirectURI:            "https://example.com/callback",
+			UseSignedRequestObject: true,
+		})
+
+		assert.ErrorContains(t, err, "a signing key must be configured using WithClientAssertion")
+	})
+
+	t.Run("Should push an authorization request", func(t *testing.T) {
+		var form url.Values
+		api := withPARServer(t, &form, WithClientSecret("test-client-secret"))
+
+		response, err := api.OAuth.PushedAuthorization(context.Background(), oauth.PushedAuthorizationRequest{
+			RedirectURI:         "https://example.com/callback",
+			Scope:               "openid profile",
+			Audience:            "test-audience",
+			State:               "test-state",
+			CodeChallenge:       "test-code-challenge",
+			CodeChallengeMethod: "S256",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "urn:ietf:params:oauth:request_uri:test", response.RequestURI)
+		assert.Equal(t, int64(30), response.ExpiresIn)
+		assert.Equal(t, url.Values{
+			"client_id":             []string{"test-client-id"},
+			"client_secret":         []string{"test-client-secret"},
+			"response_type":         []string{"code"},
+			"redirect_uri":          []string{"https://example.com/callback"},
+			"scope":                 []string{"openid profile"},
+			"audience":              []string{"test-audience"},
+			"state":                 []string{"test-state"},
+			"code_challenge":        []string{"test-code-challenge"},
+			"code_challenge_method": []string{"S256"},
+		}, form)
+
+		authorizeURL, err := url.Parse(api.OAuth.AuthorizeURL("", response.RequestURI))
+		require.NoError(t, err)
+		assert.Equal(t, "/authorize", authorizeURL.Path)
+		assert.Equal(t, url.Values{
+			"client_id":   []string{"test-client-id"},
+			"request_uri": []string{"urn:ietf:params:oauth:request_uri:test"},
+		}, authorizeURL.Query())
+	})
+
+	t.Run("Should send a signed request object", func(t *testing.T) {
+		var form url.Values
+		api := withPARServer(t, &form, WithClientAssertion(jwtPrivateKey, "RS256"))
+
+		_, err := api.OAuth.PushedAuthorization(context.Background(), oauth.PushedAuthorizationRequest{
+			RedirectURI:            "https://example.com/callback",
+			Scope:                  "openid",
+			Nonce:                  "test-nonce",
+			UseSignedRequestObject: true,
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, form.Get("redirect_uri"))
+		assert.Equal(t, "test-client-id", form.Get("client_id"))
+		assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", form.Get("client_assertion_type"))
+
+		publicKey, err := jwk.ParseKey([]byte(jwtPublicKey), jwk.WithPEM(true))
+		require.NoError(t, err)
+
+		requestObject, err := jwt.Parse([]byte(form.Get("request")), jwt.WithKey(jwa.RS256, publicKey), jwt.WithValidate(true))
+		require.NoError(t, err)
+		assert.Equal(t, "test-client-id", requestObject.Issuer())
+
+		for claim, expected := range map[string]string{
+			"client_id":     "test-client-id",
+			"response_type": "code",
+			"redirect_uri":  "https://example.com/callback",
+			"scope":         "openid",
+			"nonce":         "test-nonce",
+		} {
+			actual, ok := requestObject.Get(claim)
+			assert.True(t, ok)
+			assert.Equal(t, expected, actual)
+		}
+	})
+}
+
+func withPARServer(t *testing.T, form *url.Values, opts ...Option) *Authentication {
+	t.Helper()
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/par", r.URL.Path)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		*form = r.PostForm
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"request_uri":"urn:ietf:params:oauth:request_uri:test","expires_in":30}`)
+	})
+	s := httptest.NewTLSServer(h)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	URL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	api, err := New(
+		context.Background(),
+		URL.Host,
+		append([]Option{
+			WithClient(s.Client()),
+			WithClientID("test-client-id"),
+			WithIDTokenSigningAlg("HS256"),
+		}, opts...)...,
+	)
+	require.NoError(t, err)
+
+	return api
+}
+
 func TestWithIDTokenVerification(t *testing.T) {
 	t.Run("error for an invalid organization when using org_id", func(t *testing.T) {
 		extras := map[string]interface{}{
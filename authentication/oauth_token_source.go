@@ -0,0 +1,156 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+const defaultTokenSourceExpiryDelta = 10 * time.Second
+
+// TokenSource returns an `oauth2.TokenSource` that returns the access token from the provided TokenSet
+// until it nears expiry, then refreshes it using `RefreshToken`.
+//
+// If Auth0 returns a new refresh token (Refresh Token Rotation), it replaces the current one and is
+// passed to `OnRefreshTokenRotated` so that it can be persisted. The returned token source is safe for
+// concurrent use and can be passed directly to `oauth2.NewClient`.
+//
+// The provided context is used for all refresh requests made by the token source.
+//
+// See: https://auth0.com/docs/secure/tokens/refresh-tokens/refresh-token-rotation
+func (o *OAuth) TokenSource(ctx context.Context, t *oauth.TokenSet, opts oauth.TokenSourceOptions) oauth2.TokenSource {
+	issuedAt := opts.IssuedAt
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+
+	switch {
+	case opts.ExpiryDelta == 0:
+		opts.ExpiryDelta = defaultTokenSourceExpiryDelta
+	case opts.ExpiryDelta < 0:
+		opts.ExpiryDelta = 0
+	}
+
+	ts := &tokenSource{
+		ctx:   ctx,
+		oauth: o,
+		opts:  opts,
+	}
+
+	if t != nil {
+		ts.tokenSet = *t
+		ts.expiry = expiryFromTokenSet(t, issuedAt)
+	}
+
+	return ts
+}
+
+type tokenSource struct {
+	ctx   context.Context
+	oauth *OAuth
+	opts  oauth.TokenSourceOptions
+
+	mu       sync.Mutex
+	tokenSet oauth.TokenSet
+	expiry   time.Time
+	// Whether the refresh token was rotated but OnRefreshTokenRotated has not succeeded yet.
+	rotationPending bool
+}
+
+// Token returns the current access token, refreshing it first if it has expired or is about to.
+func (ts *tokenSource) Token() (*oauth2.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	// The previous refresh token is no longer valid once rotated, so the rotated one is kept and
+	// persisting it is retried on every call until it succeeds. A failed retry is not returned, as
+	// the access token can still be used, and the next rotation reports its own failure.
+	if ts.rotationPending {
+		_ = ts.persistRotatedRefreshToken()
+	}
+
+	if ts.tokenSet.AccessToken != "" && !ts.expiresSoon() {
+		return ts.token(), nil
+	}
+
+	if ts.tokenSet.RefreshToken == "" {
+		return nil, errors.New("access token has expired and no refresh token is available")
+	}
+
+	refreshed, err := ts.oauth.RefreshToken(ts.ctx, oauth.RefreshTokenRequest{
+		ClientAuthentication: ts.opts.ClientAuthentication,
+		RefreshToken:         ts.tokenSet.RefreshToken,
+		Scope:                ts.opts.Scope,
+	}, ts.opts.IDTokenValidationOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated := refreshed.RefreshToken != "" && refreshed.RefreshToken != ts.tokenSet.RefreshToken
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = ts.tokenSet.RefreshToken
+	}
+
+	ts.tokenSet = *refreshed
+	ts.expiry = expiryFromTokenSet(refreshed, time.Now())
+
+	if rotated {
+		ts.rotationPending = true
+		if err := ts.persistRotatedRefreshToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.token(), nil
+}
+
+// persistRotatedRefreshToken passes the current token set to OnRefreshTokenRotated, keeping the
+// rotation pending if it fails.
+func (ts *tokenSource) persistRotatedRefreshToken() error {
+	if ts.opts.OnRefreshTokenRotated != nil {
+		stored := ts.tokenSet
+		if err := ts.opts.OnRefreshTokenRotated(ts.ctx, &stored); err != nil {
+			return fmt.Errorf("failed to persist the rotated refresh token: %w", err)
+		}
+	}
+
+	ts.rotationPending = false
+	return nil
+}
+
+func (ts *tokenSource) expiresSoon() bool {
+	if ts.expiry.IsZero() {
+		return false
+	}
+
+	return time.Now().Add(ts.opts.ExpiryDelta).After(ts.expiry)
+}
+
+func (ts *tokenSource) token() *oauth2.Token {
+	token := &oauth2.Token{
+		AccessToken:  ts.tokenSet.AccessToken,
+		TokenType:    ts.tokenSet.TokenType,
+		RefreshToken: ts.tokenSet.RefreshToken,
+		Expiry:       ts.expiry,
+	}
+
+	return token.WithExtra(map[string]interface{}{
+		"id_token":   ts.tokenSet.IDToken,
+		"scope":      ts.tokenSet.Scope,
+		"expires_in": ts.tokenSet.ExpiresIn,
+	})
+}
+
+func expiryFromTokenSet(t *oauth.TokenSet, issuedAt time.Time) time.Time {
+	if t.ExpiresIn == 0 {
+		return time.Time{}
+	}
+
+	return issuedAt.Add(time.Duration(t.ExpiresIn) * time.Second)
+}
@@ -0,0 +1,222 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+func TestOAuthTokenSource(t *testing.T) {
+	t.Run("Should return the current token until it expires", func(t *testing.T) {
+		api, requests := withRefreshTokenServer(t, false)
+
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			AccessToken:  "initial-access-token",
+			RefreshToken: "initial-refresh-token",
+			ExpiresIn:    3600,
+			TokenType:    "Bearer",
+		}, oauth.TokenSourceOptions{})
+
+		token, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "initial-access-token", token.AccessToken)
+		assert.Equal(t, "initial-refresh-token", token.RefreshToken)
+		assert.True(t, token.Valid())
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("Should refresh once when used concurrently", func(t *testing.T) {
+		api, requests := withRefreshTokenServer(t, false)
+
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			AccessToken:  "initial-access-token",
+			RefreshToken: "initial-refresh-token",
+			ExpiresIn:    60,
+		}, oauth.TokenSourceOptions{
+			IssuedAt: time.Now().Add(-time.Hour),
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := ts.Token()
+				assert.NoError(t, err)
+				assert.Equal(t, "refreshed-access-token-1", token.AccessToken)
+				assert.Equal(t, "initial-refresh-token", token.RefreshToken)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("Should persist rotated refresh tokens", func(t *testing.T) {
+		api, _ := withRefreshTokenServer(t, true)
+
+		var persisted *oauth.TokenSet
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			AccessToken:  "initial-access-token",
+			RefreshToken: "initial-refresh-token",
+			ExpiresIn:    60,
+		}, oauth.TokenSourceOptions{
+			ExpiryDelta: 2 * time.Minute,
+			OnRefreshTokenRotated: func(ctx context.Context, t *oauth.TokenSet) error {
+				persisted = t
+				return nil
+			},
+		})
+
+		token, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "rotated-refresh-token-1", token.RefreshToken)
+		require.NotNil(t, persisted)
+		assert.Equal(t, "rotated-refresh-token-1", persisted.RefreshToken)
+		assert.Equal(t, "refreshed-access-token-1", persisted.AccessToken)
+	})
+
+	t.Run("Should return an error and retry when persisting fails", func(t *testing.T) {
+		api, requests := withRefreshTokenServer(t, true)
+
+		var persisted []string
+		failures := 2
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			RefreshToken: "initial-refresh-token",
+		}, oauth.TokenSourceOptions{
+			OnRefreshTokenRotated: func(ctx context.Context, t *oauth.TokenSet) error {
+				if failures > 0 {
+					failures--
+					return errors.New("storage unavailable")
+				}
+				persisted = append(persisted, t.RefreshToken)
+				return nil
+			},
+		})
+
+		_, err := ts.Token()
+		assert.ErrorContains(t, err, "failed to persist the rotated refresh token: storage unavailable")
+
+		// The access token is still valid, so it is returned although the retry fails.
+		token, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed-access-token-1", token.AccessToken)
+		assert.Empty(t, persisted)
+
+		token, err = ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed-access-token-1", token.AccessToken)
+		assert.Equal(t, []string{"rotated-refresh-token-1"}, persisted)
+		assert.Equal(t, int32(1), requests.Load())
+
+		_, err = ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rotated-refresh-token-1"}, persisted)
+	})
+
+	t.Run("Should only refresh expired tokens with a negative expiry delta", func(t *testing.T) {
+		api, requests := withRefreshTokenServer(t, false)
+
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			AccessToken:  "initial-access-token",
+			RefreshToken: "initial-refresh-token",
+			ExpiresIn:    5,
+		}, oauth.TokenSourceOptions{ExpiryDelta: -1})
+
+		token, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "initial-access-token", token.AccessToken)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("Should error when expired without a refresh token", func(t *testing.T) {
+		api, requests := withRefreshTokenServer(t, false)
+
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			AccessToken: "initial-access-token",
+			ExpiresIn:   1,
+		}, oauth.TokenSourceOptions{})
+
+		_, err := ts.Token()
+		assert.ErrorContains(t, err, "no refresh token is available")
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("Should work with oauth2.NewClient", func(t *testing.T) {
+		api, _ := withRefreshTokenServer(t, false)
+
+		ts := api.OAuth.TokenSource(context.Background(), &oauth.TokenSet{
+			RefreshToken: "initial-refresh-token",
+		}, oauth.TokenSourceOptions{})
+
+		var authorization string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+		}))
+		t.Cleanup(s.Close)
+
+		_, err := oauth2.NewClient(context.Background(), ts).Get(s.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer refreshed-access-token-1", authorization)
+	})
+}
+
+func withRefreshTokenServer(t *testing.T, rotate bool) (*Authentication, *atomic.Int32) {
+	t.Helper()
+
+	requests := &atomic.Int32{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		require.NoError(t, err)
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+
+		count := requests.Add(1)
+		tokenSet := &oauth.TokenSet{
+			AccessToken: fmt.Sprintf("refreshed-access-token-%d", count),
+			ExpiresIn:   86400,
+			TokenType:   "Bearer",
+		}
+		if rotate {
+			tokenSet.RefreshToken = fmt.Sprintf("rotated-refresh-token-%d", count)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		b, err := json.Marshal(tokenSet)
+		require.NoError(t, err)
+		fmt.Fprint(w, string(b))
+	})
+	s := httptest.NewTLSServer(h)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	URL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	api, err := New(
+		context.Background(),
+		URL.Host,
+		WithClient(s.Client()),
+		WithClientID("test-client-id"),
+		WithClientSecret("test-client-secret"),
+		WithIDTokenSigningAlg("HS256"),
+	)
+	require.NoError(t, err)
+
+	return api, requests
+}
@@ -0,0 +1,171 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// OIDCConfiguration defines the OpenID Provider Metadata returned by the OIDC discovery endpoint.
+//
+// See: https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OIDCConfiguration struct {
+	// The issuer identifier of the tenant, used as the `iss` claim of issued tokens.
+	Issuer string `json:"issuer"`
+	// URL of the authorization endpoint.
+	AuthorizationEndpoint string `json:"authorization_endpoint,omitempty"`
+	// URL of the token endpoint.
+	TokenEndpoint string `json:"token_endpoint,omitempty"`
+	// URL of the device authorization endpoint.
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+	// URL of the user info endpoint.
+	UserinfoEndpoint string `json:"userinfo_endpoint,omitempty"`
+	// URL of the MFA challenge endpoint.
+	MFAChallengeEndpoint string `json:"mfa_challenge_endpoint,omitempty"`
+	// URL of the JSON Web Key Set used to validate token signatures.
+	JWKSURI string `json:"jwks_uri,omitempty"`
+	// URL of the dynamic client registration endpoint.
+	RegistrationEndpoint string `json:"registration_endpoint,omitempty"`
+	// URL of the token revocation endpoint.
+	RevocationEndpoint string `json:"revocation_endpoint,omitempty"`
+	// URL of the OIDC RP-Initiated logout endpoint.
+	EndSessionEndpoint string `json:"end_session_endpoint,omitempty"`
+	// URL of the Pushed Authorization Request endpoint.
+	PushedAuthorizationRequestEndpoint string `json:"pushed_authorization_request_endpoint,omitempty"`
+	// URL of the Client-Initiated Backchannel Authentication endpoint.
+	BackchannelAuthenticationEndpoint string `json:"backchannel_authentication_endpoint,omitempty"`
+	// The scopes that are supported.
+	ScopesSupported []string `json:"scopes_supported,omitempty"`
+	// The response types that are supported.
+	ResponseTypesSupported []string `json:"response_types_supported,omitempty"`
+	// The response modes that are supported.
+	ResponseModesSupported []string `json:"response_modes_supported,omitempty"`
+	// The PKCE code challenge methods that are supported.
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported,omitempty"`
+	// The subject identifier types that are supported.
+	SubjectTypesSupported []string `json:"subject_types_supported,omitempty"`
+	// The algorithms that ID tokens can be signed with.
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported,omitempty"`
+	// The client authentication methods supported by the token endpoint.
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+	// The algorithms that client assertions can be signed with.
+	TokenEndpointAuthSigningAlgValuesSupported []string `json:"token_endpoint_auth_signing_alg_values_supported,omitempty"`
+	// The claims that can be returned.
+	ClaimsSupported []string `json:"claims_supported,omitempty"`
+	// Whether the `request_uri` parameter is supported.
+	RequestURIParameterSupported bool `json:"request_uri_parameter_supported,omitempty"`
+	// Whether the `request` parameter is supported.
+	RequestParameterSupported bool `json:"request_parameter_supported,omitempty"`
+	// Whether OIDC Back-Channel Logout is supported.
+	BackchannelLogoutSupported bool `json:"backchannel_logout_supported,omitempty"`
+	// Whether the `sid` claim is included in logout tokens.
+	BackchannelLogoutSessionSupported bool `json:"backchannel_logout_session_supported,omitempty"`
+	// The token delivery modes supported for Client-Initiated Backchannel Authentication.
+	BackchannelTokenDeliveryModesSupported []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+}
+
+// OIDCConfiguration returns the OpenID Provider Metadata of the tenant.
+//
+// When the client was created using the `WithOIDCDiscovery` option the document fetched during `New` is
+// returned, otherwise it is fetched on first use. The document is cached for the lifetime of the client.
+//
+// See: https://auth0.com/docs/get-started/applications/configure-applications-with-oidc-discovery
+func (a *Authentication) OIDCConfiguration(ctx context.Context) (*OIDCConfiguration, error) {
+	a.oidcConfigurationMu.Lock()
+	defer a.oidcConfigurationMu.Unlock()
+
+	if a.oidcConfiguration != nil {
+		return a.oidcConfiguration, nil
+	}
+
+	var c *OIDCConfiguration
+	err := a.Request(ctx, "GET", a.URI(".well-known", "openid-configuration"), nil, &c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the OIDC configuration: %w", err)
+	}
+
+	if c == nil || c.Issuer == "" {
+		return nil, fmt.Errorf("failed to fetch the OIDC configuration: issuer is missing from the response")
+	}
+
+	a.oidcConfiguration = c
+
+	return c, nil
+}
+
+// SupportsIDTokenSigningAlg reports whether ID tokens can be signed using the provided algorithm. If the
+// discovery document does not list any algorithms all algorithms are assumed to be supported.
+func (c *OIDCConfiguration) SupportsIDTokenSigningAlg(alg string) bool {
+	return containsOrEmpty(c.IDTokenSigningAlgValuesSupported, alg)
+}
+
+// SupportsTokenEndpointAuthMethod reports whether the token endpoint accepts the provided client
+// authentication method. If the discovery document does not list any methods all methods are assumed to
+// be supported.
+func (c *OIDCConfiguration) SupportsTokenEndpointAuthMethod(method string) bool {
+	return containsOrEmpty(c.TokenEndpointAuthMethodsSupported, method)
+}
+
+// endpoint returns the endpoint advertised in the OIDC discovery document using the selector, falling
+// back to the default path segments if `WithOIDCDiscovery` was not used or the endpoint is not advertised.
+func (a *Authentication) endpoint(selector func(c *OIDCConfiguration) string, path ...string) string {
+	a.oidcConfigurationMu.Lock()
+	c := a.oidcConfiguration
+	a.oidcConfigurationMu.Unlock()
+
+	if a.oidcDiscovery && c != nil {
+		if e := selector(c); e != "" {
+			return e
+		}
+	}
+
+	return a.URI(path...)
+}
+
+func (a *Authentication) tokenEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.TokenEndpoint }, "oauth", "token")
+}
+
+func (a *Authentication) userinfoEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.UserinfoEndpoint }, "userinfo")
+}
+
+func (a *Authentication) revocationEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.RevocationEndpoint }, "oauth", "revoke")
+}
+
+func (a *Authentication) endSessionEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.EndSessionEndpoint }, "oidc", "logout")
+}
+
+func (a *Authentication) backchannelAuthenticationEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.BackchannelAuthenticationEndpoint }, "bc-authorize")
+}
+
+func (a *Authentication) pushedAuthorizationRequestEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.PushedAuthorizationRequestEndpoint }, "oauth", "par")
+}
+
+func (a *Authentication) authorizationEndpoint() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.AuthorizationEndpoint }, "authorize")
+}
+
+// issuer returns the issuer advertised in the OIDC discovery document, falling back to the issuer derived
+// from the domain.
+func (a *Authentication) issuer() string {
+	return a.endpoint(func(c *OIDCConfiguration) string { return c.Issuer }, "")
+}
+
+func containsOrEmpty(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0/authentication/logout"
+	"github.com/ConsultingMD/go-auth0/authentication/oauth"
+)
+
+func TestOIDCDiscovery(t *testing.T) {
+	t.Run("Should use the endpoints and issuer from the discovery document", func(t *testing.T) {
+		var idToken string
+		var tokenRequests int
+		mux := http.NewServeMux()
+		s := httptest.NewTLSServer(mux)
+		t.Cleanup(func() {
+			s.Close()
+		})
+
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"issuer": "https://custom.example.com/",
+				"token_endpoint": "%[1]s/custom/token",
+				"userinfo_endpoint": "%[1]s/custom/userinfo",
+				"end_session_endpoint": "%[1]s/custom/logout",
+				"id_token_signing_alg_values_supported": ["HS256", "RS256"]
+			}`, s.URL)
+		})
+		mux.HandleFunc("/custom/token", func(w http.ResponseWriter, r *http.Request) {
+			tokenRequests++
+			b, err := json.Marshal(&oauth.TokenSet{
+				AccessToken: "test-access-token",
+				IDToken:     idToken,
+				TokenType:   "Bearer",
+			})
+			require.NoError(t, err)
+			fmt.Fprint(w, string(b))
+		})
+		mux.HandleFunc("/custom/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"sub":"test-sub"}`)
+		})
+
+		URL, err := url.Parse(s.URL)
+		require.NoError(t, err)
+
+		api, err := New(
+			context.Background(),
+			URL.Host,
+			WithClient(s.Client()),
+			WithClientID("test-client-id"),
+			WithClientSecret("test-client-secret"),
+			WithIDTokenSigningAlg("HS256"),
+			WithOIDCDiscovery(),
+		)
+		require.NoError(t, err)
+
+		token, err := jwt.NewBuilder().
+			Issuer("https://custom.example.com/").
+			Subject("me").
+			Audience([]string{"test-client-id"}).
+			Expiration(time.Now().Add(time.Hour)).
+			IssuedAt(time.Now()).
+			Build()
+		require.NoError(t, err)
+		b, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, []byte("test-client-secret")))
+		require.NoError(t, err)
+		idToken = string(b)
+
+		_, err = api.OAuth.LoginWithAuthCode(context.Background(), oauth.LoginWithAuthCodeRequest{
+			Code: "my-code",
+		}, oauth.IDTokenValidationOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tokenRequests)
+
+		user, err := api.UserInfo(context.Background(), "test-access-token")
+		require.NoError(t, err)
+		assert.Equal(t, "test-sub", user.Sub)
+
+		config, err := api.OIDCConfiguration(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "https://custom.example.com/", config.Issuer)
+		assert.Equal(t, []string{"HS256", "RS256"}, config.IDTokenSigningAlgValuesSupported)
+
+		assert.Equal(t, s.URL+"/custom/logout?client_id=test-client-id", api.Logout.OIDCURL(logout.OIDCRequest{}))
+	})
+
+	t.Run("Should error if the ID token signing algorithm is not supported", func(t *testing.T) {
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"issuer":"https://custom.example.com/","id_token_signing_alg_values_supported":["RS256"]}`)
+		})
+		s := httptest.NewTLSServer(h)
+		t.Cleanup(func() {
+			s.Close()
+		})
+
+		URL, err := url.Parse(s.URL)
+		require.NoError(t, err)
+
+		_, err = New(
+			context.Background(),
+			URL.Host,
+			WithClient(s.Client()),
+			WithIDTokenSigningAlg("HS256"),
+			WithOIDCDiscovery(),
+		)
+		assert.ErrorContains(t, err, "ID token signing algorithm \"HS256\" is not supported by the issuer")
+	})
+
+	t.Run("Should error if the discovery document cannot be fetched", func(t *testing.T) {
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		})
+		s := httptest.NewTLSServer(h)
+		t.Cleanup(func() {
+			s.Close()
+		})
+
+		URL, err := url.Parse(s.URL)
+		require.NoError(t, err)
+
+		_, err = New(
+			context.Background(),
+			URL.Host,
+			WithClient(s.Client()),
+			WithIDTokenSigningAlg("HS256"),
+			WithOIDCDiscovery(),
+		)
+		assert.ErrorContains(t, err, "failed to fetch the OIDC configuration")
+	})
+
+	t.Run("Should use the default endpoints without discovery", func(t *testing.T) {
+		api, err := New(
+			context.Background(),
+			"example.auth0.com",
+			WithIDTokenSigningAlg("HS256"),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://example.auth0.com/oauth/token", api.tokenEndpoint())
+		assert.Equal(t, "https://example.auth0.com/userinfo", api.userinfoEndpoint())
+	})
+}
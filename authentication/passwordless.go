@@ -41,7 +41,7 @@ func (p *Passwordless) LoginWithEmail(ctx context.Context, params passwordless.L
 	params.GrantType = "http://auth0.com/oauth/grant-type/passwordless/otp"
 	params.Realm = "email"
 
-	err = p.authentication.Request(ctx, "POST", p.authentication.URI("oauth", "token"), params, &t, opts...)
+	err = p.authentication.Request(ctx, "POST", p.authentication.tokenEndpoint(), params, &t, opts...)
 
 	if t != nil && t.IDToken != "" {
 		err = p.authentication.idTokenValidator.Validate(t.IDToken, idtokenvalidator.ValidationOptions{
@@ -88,7 +88,7 @@ func (p *Passwordless) LoginWithSMS(ctx context.Context, params passwordless.Log
 	params.GrantType = "http://auth0.com/oauth/grant-type/passwordless/otp"
 	params.Realm = "sms"
 
-	err = p.authentication.Request(ctx, "POST", p.authentication.URI("oauth", "token"), params, &t, opts...)
+	err = p.authentication.Request(ctx, "POST", p.authentication.tokenEndpoint(), params, &t, opts...)
 
 	if t != nil && t.IDToken != "" {
 		err = p.authentication.idTokenValidator.Validate(t.IDToken, idtokenvalidator.ValidationOptions{
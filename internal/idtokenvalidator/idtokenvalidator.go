@@ -14,6 +14,9 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
+// BackchannelLogoutEvent is the member of the `events` claim identifying a logout token.
+const BackchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
 // ValidationOptions allows validating optional claims that might not always be in the ID token.
 type ValidationOptions struct {
 	MaxAge       time.Duration
@@ -62,19 +65,24 @@ func New(
 		option(i)
 	}
 
-	if alg == jwa.RS256 {
+	if i.jwksURL == "" {
 		i.jwksURL = i.issuer + ".well-known/jwks.json"
-		i.jwks = jwk.NewCache(ctx)
-		registerOpts := []jwk.RegisterOption{}
-		if i.httpClient != nil {
-			registerOpts = append(registerOpts, jwk.WithHTTPClient(i.httpClient))
-		}
+	}
 
-		err = i.jwks.Register(i.jwksURL, registerOpts...)
-		if err != nil {
-			return nil, err
-		}
+	// The JWKS is always cached as logout tokens are signed with RS256, but it is only fetched
+	// up front when ID tokens are too.
+	i.jwks = jwk.NewCache(ctx)
+	registerOpts := []jwk.RegisterOption{}
+	if i.httpClient != nil {
+		registerOpts = append(registerOpts, jwk.WithHTTPClient(i.httpClient))
+	}
+
+	err = i.jwks.Register(i.jwksURL, registerOpts...)
+	if err != nil {
+		return nil, err
+	}
 
+	if alg == jwa.RS256 {
 		_, err = i.jwks.Refresh(ctx, i.jwksURL)
 		if err != nil {
 			return nil, err
@@ -201,6 +209,79 @@ func (i *IDTokenValidator) Validate(idToken string, optional ValidationOptions)
 	return err
 }
 
+// ValidateLogoutToken validates an OIDC Back-Channel Logout token and returns the parsed token.
+//
+// Logout tokens are always signed with the tenant's signing keys, so when the validator has been
+// configured for HS256 the JWKS is fetched with the provided context on first use and then cached.
+//
+// See: https://openid.net/specs/openid-connect-backchannel-1_0.html#Validation
+func (i *IDTokenValidator) ValidateLogoutToken(ctx context.Context, logoutToken string) (jwt.Token, error) {
+	validator := jwt.ValidatorFunc(func(_ context.Context, t jwt.Token) jwt.ValidationError {
+		if t.JwtID() == "" {
+			return jwt.NewValidationError(errors.New("jti claim must be a string present in the logout token"))
+		}
+
+		sid, _ := t.Get("sid")
+		if t.Subject() == "" && sid == nil {
+			return jwt.NewValidationError(errors.New("sub or sid claim must be present in the logout token"))
+		}
+
+		if _, exists := t.Get("nonce"); exists {
+			return jwt.NewValidationError(errors.New("nonce claim must not be present in the logout token"))
+		}
+
+		events, exists := t.Get("events")
+		if !exists {
+			return jwt.NewValidationError(errors.New("events claim must be present in the logout token"))
+		}
+
+		eventsMap, ok := events.(map[string]interface{})
+		if !ok {
+			return jwt.NewValidationError(errors.New("events claim must be a JSON object in the logout token"))
+		}
+
+		if _, ok := eventsMap[BackchannelLogoutEvent].(map[string]interface{}); !ok {
+			return jwt.NewValidationError(fmt.Errorf("events claim must contain a \"%s\" member in the logout token", BackchannelLogoutEvent))
+		}
+
+		return nil
+	})
+
+	decodedToken, err := jws.Parse([]byte(logoutToken))
+	if err != nil {
+		return nil, err
+	}
+
+	headers := decodedToken.Signatures()[0].ProtectedHeaders()
+	if headers.Algorithm() != jwa.RS256 {
+		return nil, fmt.Errorf("signature algorithm \"%s\" is not supported. Expected the logout token to be signed with \"RS256\"", headers.Algorithm())
+	}
+
+	keySet, err := i.keySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.Parse(
+		[]byte(logoutToken),
+		jwt.WithValidate(true),
+		jwt.WithAcceptableSkew(i.clockTolerance),
+		jwt.WithRequiredClaim("aud"),
+		jwt.WithRequiredClaim("iss"),
+		jwt.WithRequiredClaim("iat"),
+		jwt.WithAudience(i.audience),
+		jwt.WithIssuer(i.issuer),
+		jwt.WithValidator(validator),
+		jwt.WithKeySet(keySet),
+	)
+}
+
+// keySet returns the cached JWKS, fetching it with the context the first time when the validator
+// has been configured for HS256.
+func (i *IDTokenValidator) keySet(ctx context.Context) (jwk.Set, error) {
+	return i.jwks.Get(ctx, i.jwksURL)
+}
+
 func determineAlg(alg string) (jwa.SignatureAlgorithm, error) {
 	switch alg {
 	case "HS256":
@@ -21,3 +21,18 @@ func WithHTTPClient(client *http.Client) Option {
 		iv.httpClient = client
 	}
 }
+
+// WithIssuer configures the expected issuer of ID tokens, overriding the one derived from the domain.
+func WithIssuer(issuer string) Option {
+	return func(iv *IDTokenValidator) {
+		iv.issuer = issuer
+	}
+}
+
+// WithJWKSURL configures the URL the JSON Web Key Set is fetched from, overriding the one derived
+// from the issuer.
+func WithJWKSURL(jwksURL string) Option {
+	return func(iv *IDTokenValidator) {
+		iv.jwksURL = jwksURL
+	}
+}
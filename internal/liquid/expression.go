@@ -0,0 +1,473 @@
+package liquid
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type itemKind int
+
+const (
+	itemString itemKind = iota
+	itemNumber
+	itemIdent
+	itemOperator
+	itemPunct
+)
+
+// item is a lexical element of an expression.
+type item struct {
+	kind itemKind
+	text string
+}
+
+// lexExpression splits an expression into items.
+func lexExpression(s string) ([]item, error) {
+	var items []item
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string in %q", s)
+			}
+			items = append(items, item{itemString, s[i+1 : i+1+end]})
+			i += end + 2
+		case isDigit(c) || (c == '-' && i+1 < len(s) && isDigit(s[i+1])):
+			j := i + 1
+			for j < len(s) && (isDigit(s[j]) || (s[j] == '.' && j+1 < len(s) && isDigit(s[j+1]))) {
+				j++
+			}
+			items = append(items, item{itemNumber, s[i:j]})
+			i = j
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(s) && (isIdentStart(s[j]) || isDigit(s[j]) || s[j] == '-' || s[j] == '?') {
+				j++
+			}
+			items = append(items, item{itemIdent, s[i:j]})
+			i = j
+		case strings.HasPrefix(s[i:], "=="), strings.HasPrefix(s[i:], "!="), strings.HasPrefix(s[i:], "<>"),
+			strings.HasPrefix(s[i:], "<="), strings.HasPrefix(s[i:], ">="):
+			items = append(items, item{itemOperator, s[i : i+2]})
+			i += 2
+		case c == '<' || c == '>':
+			items = append(items, item{itemOperator, s[i : i+1]})
+			i++
+		case strings.HasPrefix(s[i:], ".."):
+			items = append(items, item{itemPunct, ".."})
+			i += 2
+		case strings.ContainsRune("|:,.[]()=", rune(c)):
+			items = append(items, item{itemPunct, s[i : i+1]})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q in %q", c, s)
+		}
+	}
+	return items, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// operand is a value of an expression: a literal, a variable or a range.
+type operand interface {
+	// eval returns the value of the operand, and false if it refers to an undefined variable.
+	eval(c *renderContext) (interface{}, bool)
+}
+
+type literal struct {
+	value interface{}
+}
+
+func (l literal) eval(*renderContext) (interface{}, bool) {
+	return l.value, true
+}
+
+// emptyValue is the value of the `empty` and `blank` keywords.
+type emptyValue struct{}
+
+type segment struct {
+	key   string
+	index operand
+}
+
+type variable struct {
+	name     string
+	segments []segment
+}
+
+func (v *variable) String() string {
+	var b strings.Builder
+	b.WriteString(v.name)
+	for _, s := range v.segments {
+		if s.index != nil {
+			if l, ok := s.index.(literal); ok {
+				fmt.Fprintf(&b, "[%s]", strconv.Quote(toString(l.value)))
+			} else {
+				b.WriteString("[...]")
+			}
+			continue
+		}
+		b.WriteString("." + s.key)
+	}
+	return b.String()
+}
+
+func (v *variable) eval(c *renderContext) (interface{}, bool) {
+	value, ok := c.lookup(v.name)
+	if !ok {
+		return nil, false
+	}
+
+	for _, s := range v.segments {
+		key := s.key
+		if s.index != nil {
+			index, _ := s.index.eval(c)
+			if n, isNumber := index.(float64); isNumber {
+				list, isList := value.([]interface{})
+				if !isList {
+					return nil, false
+				}
+				i := int(n)
+				if i < 0 {
+					i += len(list)
+				}
+				if i < 0 || i >= len(list) {
+					return nil, false
+				}
+				value = list[i]
+				continue
+			}
+			key = toString(index)
+		}
+
+		switch current := value.(type) {
+		case map[string]interface{}:
+			next, exists := current[key]
+			if !exists {
+				if key == "size" {
+					value = float64(len(current))
+					continue
+				}
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			switch key {
+			case "size":
+				value = float64(len(current))
+			case "first", "last":
+				if len(current) == 0 {
+					return nil, true
+				}
+				if key == "first" {
+					value = current[0]
+				} else {
+					value = current[len(current)-1]
+				}
+			default:
+				return nil, false
+			}
+		case string:
+			if key != "size" {
+				return nil, false
+			}
+			value = float64(len([]rune(current)))
+		default:
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+type rangeOperand struct {
+	from, to operand
+}
+
+// maxRangeLength is the maximum number of values of a range, as in Shopify's Liquid.
+const maxRangeLength = 10000
+
+// bounds returns the first value of the range and its number of values, failing if the range
+// is longer than maxRangeLength.
+func (r rangeOperand) bounds(c *renderContext) (from float64, length int, defined bool, err error) {
+	fromValue, fromDefined := r.from.eval(c)
+	toValue, toDefined := r.to.eval(c)
+
+	from, to := math.Trunc(toNumber(fromValue)), math.Trunc(toNumber(toValue))
+	if !(to-from < maxRangeLength) {
+		return 0, 0, false, fmt.Errorf("range (%s..%s) has more than %d values",
+			strconv.FormatFloat(from, 'f', 0, 64), strconv.FormatFloat(to, 'f', 0, 64), maxRangeLength)
+	}
+	if to >= from {
+		length = int(to-from) + 1
+	}
+	return from, length, fromDefined && toDefined, nil
+}
+
+func (r rangeOperand) eval(c *renderContext) (interface{}, bool) {
+	from, length, defined, err := r.bounds(c)
+	if err == nil {
+		err = c.iterate(length)
+	}
+	if err != nil {
+		c.fail(err)
+		return nil, false
+	}
+
+	var values []interface{}
+	for i := 0; i < length; i++ {
+		values = append(values, from+float64(i))
+	}
+	return values, defined
+}
+
+type filterCall struct {
+	name string
+	args []operand
+	fn   filterFunc
+}
+
+// expression is an operand transformed by filters.
+type expression struct {
+	operand operand
+	filters []filterCall
+}
+
+type comparison struct {
+	left     operand
+	operator string
+	right    operand
+}
+
+// condition is a list of comparisons joined by `and` and `or`, evaluated from right to left.
+type condition struct {
+	comparisons []comparison
+	joins       []string
+}
+
+// exprParser parses the items of an expression.
+type exprParser struct {
+	items []item
+	pos   int
+	src   string
+}
+
+func newExprParser(s string) (*exprParser, error) {
+	items, err := lexExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	return &exprParser{items: items, src: s}, nil
+}
+
+func (p *exprParser) done() bool {
+	return p.pos >= len(p.items)
+}
+
+func (p *exprParser) peek() (item, bool) {
+	if p.done() {
+		return item{}, false
+	}
+	return p.items[p.pos], true
+}
+
+func (p *exprParser) accept(kind itemKind, text string) bool {
+	next, ok := p.peek()
+	if ok && next.kind == kind && next.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expectEnd() error {
+	if next, ok := p.peek(); ok {
+		return fmt.Errorf("unexpected %q in %q", next.text, p.src)
+	}
+	return nil
+}
+
+func (p *exprParser) ident() (string, error) {
+	next, ok := p.peek()
+	if !ok || next.kind != itemIdent {
+		return "", fmt.Errorf("expected a name in %q", p.src)
+	}
+	p.pos++
+	return next.text, nil
+}
+
+func (p *exprParser) operand() (operand, error) {
+	next, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("expected a value in %q", p.src)
+	}
+	p.pos++
+
+	switch next.kind {
+	case itemString:
+		return literal{next.text}, nil
+	case itemNumber:
+		n, err := strconv.ParseFloat(next.text, 64)
+		if err != nil {
+			return nil, err
+		}
+		return literal{n}, nil
+	case itemPunct:
+		if next.text != "(" {
+			break
+		}
+		from, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(itemPunct, "..") {
+			return nil, fmt.Errorf("expected .. in range of %q", p.src)
+		}
+		to, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(itemPunct, ")") {
+			return nil, fmt.Errorf("expected ) closing range of %q", p.src)
+		}
+		return rangeOperand{from, to}, nil
+	case itemIdent:
+		switch next.text {
+		case "true":
+			return literal{true}, nil
+		case "false":
+			return literal{false}, nil
+		case "nil", "null":
+			return literal{nil}, nil
+		case "empty", "blank":
+			return literal{emptyValue{}}, nil
+		}
+
+		v := &variable{name: next.text}
+		for {
+			switch {
+			case p.accept(itemPunct, "."):
+				key, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				v.segments = append(v.segments, segment{key: key})
+			case p.accept(itemPunct, "["):
+				index, err := p.operand()
+				if err != nil {
+					return nil, err
+				}
+				if !p.accept(itemPunct, "]") {
+					return nil, fmt.Errorf("expected ] in %q", p.src)
+				}
+				v.segments = append(v.segments, segment{index: index})
+			default:
+				return v, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %q in %q", next.text, p.src)
+}
+
+// expression parses `operand | filter: arg, arg | filter`.
+func (p *exprParser) expression() (*expression, error) {
+	value, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &expression{operand: value}
+	for p.accept(itemPunct, "|") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		fn, ok := filters[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %q", name)
+		}
+
+		call := filterCall{name: name, fn: fn}
+		if p.accept(itemPunct, ":") {
+			for {
+				arg, err := p.operand()
+				if err != nil {
+					return nil, err
+				}
+				call.args = append(call.args, arg)
+				if !p.accept(itemPunct, ",") {
+					break
+				}
+			}
+		}
+		e.filters = append(e.filters, call)
+	}
+
+	return e, nil
+}
+
+var comparisonOperators = map[string]bool{"==": true, "!=": true, "<>": true, "<": true, ">": true, "<=": true, ">=": true}
+
+// condition parses `comparison [and|or comparison]...`.
+func (p *exprParser) condition() (*condition, error) {
+	c := &condition{}
+	for {
+		left, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		cmp := comparison{left: left}
+		if next, ok := p.peek(); ok &&
+			((next.kind == itemOperator && comparisonOperators[next.text]) || (next.kind == itemIdent && next.text == "contains")) {
+			p.pos++
+			cmp.operator = next.text
+			if cmp.right, err = p.operand(); err != nil {
+				return nil, err
+			}
+		}
+		c.comparisons = append(c.comparisons, cmp)
+
+		switch {
+		case p.accept(itemIdent, "and"):
+			c.joins = append(c.joins, "and")
+		case p.accept(itemIdent, "or"):
+			c.joins = append(c.joins, "or")
+		default:
+			return c, p.expectEnd()
+		}
+	}
+}
+
+func parseExpression(s string) (*expression, error) {
+	p, err := newExprParser(s)
+	if err != nil {
+		return nil, err
+	}
+	e, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return e, p.expectEnd()
+}
+
+func parseCondition(s string) (*condition, error) {
+	p, err := newExprParser(s)
+	if err != nil {
+		return nil, err
+	}
+	return p.condition()
+}
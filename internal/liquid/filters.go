@@ -0,0 +1,369 @@
+package liquid
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// filterFunc transforms a value with the arguments of a filter.
+type filterFunc func(value interface{}, args []interface{}) (interface{}, error)
+
+var filters = map[string]filterFunc{
+	"append":         stringFilter(1, func(s string, args []string) string { return s + args[0] }),
+	"prepend":        stringFilter(1, func(s string, args []string) string { return args[0] + s }),
+	"capitalize":     stringFilter(0, capitalize),
+	"downcase":       stringFilter(0, func(s string, _ []string) string { return strings.ToLower(s) }),
+	"upcase":         stringFilter(0, func(s string, _ []string) string { return strings.ToUpper(s) }),
+	"escape":         stringFilter(0, func(s string, _ []string) string { return html.EscapeString(s) }),
+	"strip":          stringFilter(0, func(s string, _ []string) string { return strings.TrimSpace(s) }),
+	"lstrip":         stringFilter(0, func(s string, _ []string) string { return strings.TrimLeftFunc(s, unicode.IsSpace) }),
+	"rstrip":         stringFilter(0, func(s string, _ []string) string { return strings.TrimRightFunc(s, unicode.IsSpace) }),
+	"strip_html":     stringFilter(0, func(s string, _ []string) string { return htmlTagPattern.ReplaceAllString(s, "") }),
+	"strip_newlines": stringFilter(0, func(s string, _ []string) string { return newlinePattern.ReplaceAllString(s, "") }),
+	"newline_to_br":  stringFilter(0, func(s string, _ []string) string { return newlinePattern.ReplaceAllString(s, "<br />\n") }),
+	"url_encode":     stringFilter(0, func(s string, _ []string) string { return url.QueryEscape(s) }),
+	"replace":        replace,
+	"replace_first":  stringFilter(2, func(s string, args []string) string { return strings.Replace(s, args[0], args[1], 1) }),
+	"remove":         stringFilter(1, func(s string, args []string) string { return strings.ReplaceAll(s, args[0], "") }),
+	"remove_first":   stringFilter(1, func(s string, args []string) string { return strings.Replace(s, args[0], "", 1) }),
+	"truncate":       truncate,
+	"split":          split,
+	"join":           join,
+	"size":           size,
+	"first":          func(value interface{}, _ []interface{}) (interface{}, error) { return element(value, 0), nil },
+	"last":           func(value interface{}, _ []interface{}) (interface{}, error) { return element(value, -1), nil },
+	"default":        defaultValue,
+	"date":           date,
+	"plus":           arithmetic(func(a, b float64) float64 { return a + b }),
+	"minus":          arithmetic(func(a, b float64) float64 { return a - b }),
+	"times":          arithmetic(func(a, b float64) float64 { return a * b }),
+	"divided_by":     divide,
+	"modulo":         modulo,
+}
+
+var (
+	htmlTagPattern = regexp.MustCompile(`(?s)<script.*?</script>|<style.*?</style>|<!--.*?-->|<[^>]*>`)
+	newlinePattern = regexp.MustCompile(`\r?\n`)
+)
+
+func checkArgs(args []interface{}, count int) error {
+	if len(args) < count {
+		return fmt.Errorf("expected %d arguments, got %d", count, len(args))
+	}
+	return nil
+}
+
+// stringFilter converts the value and the arguments of a filter to strings.
+func stringFilter(count int, fn func(s string, args []string) string) filterFunc {
+	return func(value interface{}, args []interface{}) (interface{}, error) {
+		if err := checkArgs(args, count); err != nil {
+			return nil, err
+		}
+		strings := make([]string, len(args))
+		for i, arg := range args {
+			strings[i] = toString(arg)
+		}
+		return fn(toString(value), strings), nil
+	}
+}
+
+func capitalize(s string, _ []string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	if n == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + strings.ToLower(s[n:])
+}
+
+func truncate(value interface{}, args []interface{}) (interface{}, error) {
+	length, ellipsis := 50, "..."
+	if len(args) > 0 {
+		length = int(toNumber(args[0]))
+	}
+	if len(args) > 1 {
+		ellipsis = toString(args[1])
+	}
+
+	runes := []rune(toString(value))
+	if len(runes) <= length {
+		return string(runes), nil
+	}
+	keep := length - utf8.RuneCountInString(ellipsis)
+	if keep < 0 {
+		keep = 0
+	}
+	return string(runes[:keep]) + ellipsis, nil
+}
+
+// replace checks the size of the result first, as replacing a short or empty string can multiply
+// the size of the value.
+func replace(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 2); err != nil {
+		return nil, err
+	}
+	s, old, replacement := toString(value), toString(args[0]), toString(args[1])
+	if len(s)+strings.Count(s, old)*(len(replacement)-len(old)) > maxOutputSize {
+		return nil, errOutputTooLarge
+	}
+	return strings.ReplaceAll(s, old, replacement), nil
+}
+
+func split(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	s := toString(value)
+	if s == "" {
+		return []interface{}{}, nil
+	}
+	parts := strings.Split(s, toString(args[0]))
+	values := make([]interface{}, len(parts))
+	for i, part := range parts {
+		values[i] = part
+	}
+	return values, nil
+}
+
+func join(value interface{}, args []interface{}) (interface{}, error) {
+	separator := " "
+	if len(args) > 0 {
+		separator = toString(args[0])
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return toString(value), nil
+	}
+	// The size is checked while converting the items, before joining them.
+	parts := make([]string, len(list))
+	length := 0
+	for i, item := range list {
+		parts[i] = toString(item)
+		length += len(parts[i]) + len(separator)
+		if length > maxOutputSize+len(separator) {
+			return nil, errOutputTooLarge
+		}
+	}
+	return strings.Join(parts, separator), nil
+}
+
+func size(value interface{}, _ []interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return float64(utf8.RuneCountInString(v)), nil
+	case []interface{}:
+		return float64(len(v)), nil
+	case map[string]interface{}:
+		return float64(len(v)), nil
+	}
+	return float64(0), nil
+}
+
+func element(value interface{}, index int) interface{} {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	if index < 0 {
+		index += len(list)
+	}
+	return list[index]
+}
+
+func defaultValue(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	if !truthy(value) || isEmpty(value) {
+		return args[0], nil
+	}
+	return value, nil
+}
+
+var strftimeDirectives = map[byte]string{
+	'Y': "2006", 'y': "06", 'm': "01", 'd': "02", 'e': "_2", 'H': "15", 'I': "03", 'M': "04", 'S': "05",
+	'p': "PM", 'B': "January", 'b': "Jan", 'h': "Jan", 'A': "Monday", 'a': "Mon", 'Z': "MST", 'z': "-0700",
+}
+
+// date formats a date with a subset of the strftime directives. The value is either `now`, a
+// RFC 3339 date, or a Unix timestamp in seconds.
+func date(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+
+	var t time.Time
+	switch v := value.(type) {
+	case float64:
+		t = time.Unix(int64(v), 0).UTC()
+	case string:
+		var err error
+		switch v {
+		case "now", "today":
+			t = time.Now().UTC()
+		default:
+			if t, err = time.Parse(time.RFC3339, v); err != nil {
+				return value, nil
+			}
+		}
+	default:
+		return value, nil
+	}
+
+	format := toString(args[0])
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		switch directive := format[i]; directive {
+		case '%':
+			b.WriteByte('%')
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 's':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		default:
+			layout, ok := strftimeDirectives[directive]
+			if !ok {
+				b.WriteByte('%')
+				b.WriteByte(directive)
+				continue
+			}
+			b.WriteString(t.Format(layout))
+		}
+	}
+	return b.String(), nil
+}
+
+func arithmetic(fn func(a, b float64) float64) filterFunc {
+	return func(value interface{}, args []interface{}) (interface{}, error) {
+		if err := checkArgs(args, 1); err != nil {
+			return nil, err
+		}
+		return fn(toNumber(value), toNumber(args[0])), nil
+	}
+}
+
+// divide divides numbers, rounding down when both are whole as Liquid does for integers.
+func divide(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	a, b := toNumber(value), toNumber(args[0])
+	if b == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	if a == math.Trunc(a) && b == math.Trunc(b) {
+		return math.Floor(a / b), nil
+	}
+	return a / b, nil
+}
+
+func modulo(value interface{}, args []interface{}) (interface{}, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	b := toNumber(args[0])
+	if b == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return math.Mod(toNumber(value), b), nil
+}
+
+// toString converts a value to the text it renders to.
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil, emptyValue:
+		return ""
+	case string:
+		return v
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		var b strings.Builder
+		for _, item := range v {
+			b.WriteString(toString(item))
+		}
+		return b.String()
+	}
+	return fmt.Sprint(value)
+}
+
+func toNumber(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		n, _ := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n
+	}
+	return 0
+}
+
+// truthy follows Liquid, where only nil and false are falsy.
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+	return true
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil, emptyValue:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	if _, ok := a.(emptyValue); ok {
+		return isEmpty(b)
+	}
+	if _, ok := b.(emptyValue); ok {
+		return isEmpty(a)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func contains(container, value interface{}) bool {
+	switch c := container.(type) {
+	case string:
+		return strings.Contains(c, toString(value))
+	case []interface{}:
+		for _, item := range c {
+			if equal(item, value) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		_, ok := c[toString(value)]
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package liquid
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenText tokenKind = iota
+	tokenOutput
+	tokenTag
+)
+
+// token is a piece of a template: raw text, an output `{{ ... }}` or a tag `{% ... %}`.
+type token struct {
+	kind tokenKind
+	// The text, the expression of the output, or the arguments of the tag.
+	value string
+	// The name of the tag.
+	tag  string
+	line int
+}
+
+// lex splits the source of a template into tokens, applying whitespace control.
+func lex(source string) ([]token, error) {
+	var tokens []token
+	line := 1
+	trimNext := false
+
+	for len(source) > 0 {
+		start := nextDelimiter(source)
+		if start < 0 {
+			tokens = appendText(tokens, source, line, trimNext, false)
+			break
+		}
+
+		isOutput := strings.HasPrefix(source[start:], "{{")
+		closing := "%}"
+		if isOutput {
+			closing = "}}"
+		}
+
+		inner := source[start+2:]
+		trimPrevious := strings.HasPrefix(inner, "-")
+		if trimPrevious {
+			inner = inner[1:]
+		}
+
+		end := strings.Index(inner, closing)
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: %q is not closed", line+strings.Count(source[:start], "\n"), source[start:start+2])
+		}
+		content := inner[:end]
+		trimAfter := strings.HasSuffix(content, "-")
+		if trimAfter {
+			content = content[:len(content)-1]
+		}
+
+		tokens = appendText(tokens, source[:start], line, trimNext, trimPrevious)
+		line += strings.Count(source[:start], "\n")
+
+		content = strings.TrimSpace(content)
+		if isOutput {
+			tokens = append(tokens, token{kind: tokenOutput, value: content, line: line})
+		} else {
+			name, args := splitTag(content)
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty tag", line)
+			}
+			tokens = append(tokens, token{kind: tokenTag, tag: name, value: args, line: line})
+		}
+
+		consumed := start + 2 + len(inner[:end]) + len(closing)
+		if trimPrevious {
+			consumed++
+		}
+		line += strings.Count(source[start:consumed], "\n")
+		source = source[consumed:]
+		trimNext = trimAfter
+
+		// The content of raw tags is not parsed.
+		if !isOutput && tokens[len(tokens)-1].tag == "raw" {
+			rawEnd := rawEndPattern(source)
+			if rawEnd.start < 0 {
+				return nil, fmt.Errorf("line %d: raw is not closed", line)
+			}
+			tokens = appendText(tokens, source[:rawEnd.start], line, trimNext, false)
+			line += strings.Count(source[:rawEnd.end], "\n")
+			source = source[rawEnd.end:]
+			tokens = append(tokens, token{kind: tokenTag, tag: "endraw", line: line})
+			trimNext = false
+		}
+	}
+
+	return tokens, nil
+}
+
+func nextDelimiter(source string) int {
+	output := strings.Index(source, "{{")
+	tag := strings.Index(source, "{%")
+	switch {
+	case output < 0:
+		return tag
+	case tag < 0:
+		return output
+	case output < tag:
+		return output
+	default:
+		return tag
+	}
+}
+
+func appendText(tokens []token, text string, line int, trimLeft, trimRight bool) []token {
+	if trimLeft {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+	}
+	if trimRight {
+		text = strings.TrimRightFunc(text, unicode.IsSpace)
+	}
+	if text == "" {
+		return tokens
+	}
+	return append(tokens, token{kind: tokenText, value: text, line: line})
+}
+
+func splitTag(content string) (name, args string) {
+	end := strings.IndexFunc(content, unicode.IsSpace)
+	if end < 0 {
+		return content, ""
+	}
+	return content[:end], strings.TrimSpace(content[end:])
+}
+
+type span struct {
+	start, end int
+}
+
+// rawEndPattern finds the `{% endraw %}` tag, allowing whitespace control and spacing.
+func rawEndPattern(source string) span {
+	offset := 0
+	for {
+		start := strings.Index(source[offset:], "{%")
+		if start < 0 {
+			return span{-1, -1}
+		}
+		start += offset
+		end := strings.Index(source[start:], "%}")
+		if end < 0 {
+			return span{-1, -1}
+		}
+		end += start + 2
+
+		content := strings.Trim(source[start+2:end-2], "- \t\r\n")
+		if content == "endraw" {
+			return span{start, end}
+		}
+		offset = end
+	}
+}
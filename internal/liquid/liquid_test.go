@@ -0,0 +1,242 @@
+package liquid
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	variables := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name":           "Jane Doe",
+			"email":          "jane@example.com",
+			"email_verified": false,
+			"logins":         3,
+			"roles":          []string{"admin", "editor"},
+		},
+		"price": 12.5,
+		"html":  "<p>Hello <b>there</b></p>",
+	}
+
+	var testCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"text", "Hello", "Hello"},
+		{"output", "Hello {{ user.name }}!", "Hello Jane Doe!"},
+		{"bracket access", `{{ user["email"] }}`, "jane@example.com"},
+		{"index", "{{ user.roles[0] }} {{ user.roles[-1] }}", "admin editor"},
+		{"size", "{{ user.roles.size }} {{ user.name.size }}", "2 8"},
+		{"whole number", "{{ user.logins }}", "3"},
+		{"decimal number", "{{ price }}", "12.5"},
+		{"filters", "{{ user.name | upcase | append: '!' }}", "JANE DOE!"},
+		{"default", "{{ user.nickname | default: 'friend' }}", "friend"},
+		{"arithmetic", "{{ user.logins | plus: 2 | times: 3 }} {{ 7 | divided_by: 2 }} {{ 7 | modulo: 4 }}", "15 3 3"},
+		{"split and join", "{{ 'a,b,c' | split: ',' | join: '-' }}", "a-b-c"},
+		{"truncate", "{{ 'Hello world' | truncate: 8 }}", "Hello..."},
+		{"strip html", "{{ html | strip_html }}", "Hello there"},
+		{"escape", "{{ html | escape }}", "&lt;p&gt;Hello &lt;b&gt;there&lt;/b&gt;&lt;/p&gt;"},
+		{"date", "{{ '2023-04-05T06:07:08Z' | date: '%Y-%m-%d %H:%M' }}", "2023-04-05 06:07"},
+		{"if", "{% if user.email_verified %}yes{% else %}no{% endif %}", "no"},
+		{"elsif", "{% if user.logins > 5 %}many{% elsif user.logins > 1 %}some{% else %}one{% endif %}", "some"},
+		{"unless", "{% unless user.email_verified %}verify{% endunless %}", "verify"},
+		{"and or", "{% if false and true or true %}yes{% endif %}", ""},
+		{"contains", "{% if user.roles contains 'admin' and user.email contains '@' %}admin{% endif %}", "admin"},
+		{"empty", "{% if user.nickname == empty %}none{% endif %}{% if user.roles != empty %} roles{% endif %}", "none roles"},
+		{"case", "{% case user.roles[0] %}{% when 'editor' %}E{% when 'admin', 'owner' %}A{% else %}?{% endcase %}", "A"},
+		{"for", "{% for role in user.roles %}{{ forloop.index }}.{{ role }}{% unless forloop.last %},{% endunless %}{% endfor %}", "1.admin,2.editor"},
+		{"for range", "{% for i in (1..5) limit: 2 offset: 1 reversed %}{{ i }}{% endfor %}", "32"},
+		{"for else", "{% for item in user.nothing %}x{% else %}empty{% endfor %}", "empty"},
+		{"assign", "{% assign greeting = 'Hi ' | append: user.name %}{{ greeting }}", "Hi Jane Doe"},
+		{"capture", "{% capture link %}https://example.com/{{ user.logins }}{% endcapture %}{{ link }}", "https://example.com/3"},
+		{"comment", "a{% comment %}{{ ignored }}{% if %}{% endcomment %}b", "ab"},
+		{"raw", "{% raw %}{{ user.name }}{% endraw %}", "{{ user.name }}"},
+		{"whitespace control", "a  {{- user.logins -}}  b\n{%- if true -%}\n c {%- endif %}", "a3bc"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Render(testCase.template, variables)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result.Output)
+		})
+	}
+}
+
+func TestRender_Undefined(t *testing.T) {
+	result, err := Render(
+		"{{ user.name }} {{ user.nickname }} {{ missing | default: 'x' }}"+
+			"{% if other %}{{ other }}{% endif %}{% for item in items %}{{ item }}{% endfor %}",
+		map[string]interface{}{"user": map[string]interface{}{"name": "Jane"}},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Jane  x", result.Output)
+	assert.Equal(t, []string{"items", "user.nickname"}, result.Undefined)
+}
+
+func TestRender_Ranges(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"largest range", "{% for i in (1..10000) offset: 9998 %}{{ i }} {% endfor %}", "9999 10000 "},
+		{"empty range", "{% for i in (5..1) %}{{ i }}{% else %}empty{% endfor %}", "empty"},
+		{"assigned range", "{% assign numbers = (-1..1) %}{{ numbers | join: ',' }}", "-1,0,1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Render(testCase.template, nil)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result.Output)
+		})
+	}
+
+	var errorCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"huge range", "{% for i in (1..100000000) %}{{ i }}{% endfor %}", "range (1..100000000) has more than 10000 values"},
+		{"range up to MaxInt", "{% for i in (1..to) %}{{ i }}{% endfor %}", "range (1..9223372036854775808) has more than 10000 values"},
+		{"huge assigned range", "{% assign numbers = (1..to) %}{{ numbers | size }}", "range (1..9223372036854775808) has more than 10000 values"},
+		{"huge output range", "{{ (0..10000) | size }}", "range (0..10000) has more than 10000 values"},
+		{"decimal bounds", "{{ (1.5..20000.9) | size }}", "range (1..20000) has more than 10000 values"},
+	}
+
+	for _, testCase := range errorCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Render(testCase.template, map[string]interface{}{"to": math.MaxInt})
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+}
+
+func TestRender_Limits(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"nested loops", "{% for i in (1..10000) %}{% for j in (1..10000) %}{% endfor %}{% endfor %}", "more than 10000 loop iterations"},
+		{"ranges in a loop", "{% for i in (1..2) %}{% assign numbers = (1..10000) %}{% endfor %}", "more than 10000 loop iterations"},
+		{"output", "{% for i in (1..2000) %}{{ text }}{% endfor %}", "output is larger than 262144 bytes"},
+		{"capture", "{% capture all %}{% for i in (1..10000) %}{{ text }}{% endfor %}{% endcapture %}", "output is larger than 262144 bytes"},
+		{"doubling append", "{% assign s = text %}{% for i in (1..100) %}{% assign s = s | append: s %}{% endfor %}", `filter "append": output is larger than 262144 bytes`},
+		{"replace", "{% assign s = text | append: text %}{% for i in (1..10) %}{% assign s = s | replace: '', s %}{% endfor %}", `filter "replace": output is larger than 262144 bytes`},
+		{"join", "{{ (1..10000) | join: text }}", `filter "join": output is larger than 262144 bytes`},
+		{"copies in a loop", "{% capture s %}{% for i in (1..200) %}{{ text }}{% endfor %}{% endcapture %}{% for i in (1..100) %}{% assign t = s | append: 'x' %}{% endfor %}", `filter "append": filters and captures built more than 8388608 bytes`},
+		{"captures in a loop", "{% for i in (1..100) %}{% capture c %}{% for j in (1..99) %}{{ text }}{{ text }}{% endfor %}{% endcapture %}{% endfor %}", "filters and captures built more than 8388608 bytes"},
+	}
+
+	text := strings.Repeat("x", 1000)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Render(testCase.template, map[string]interface{}{"text": text})
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+
+	result, err := Render("{% for i in (1..200) %}{{ text }}{% endfor %}{{ (1..200) | join: text | size }}", map[string]interface{}{"text": text})
+	require.NoError(t, err)
+	assert.Len(t, result.Output, 200*1000+len("199492"))
+}
+
+func TestFilters(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"append", "{{ 'a' | append: 'b' }}", "ab"},
+		{"prepend", "{{ 'a' | prepend: 'b' }}", "ba"},
+		{"capitalize", "{{ 'hELLO world' | capitalize }} {{ '' | capitalize }}", "Hello world "},
+		{"downcase", "{{ 'ABC' | downcase }}", "abc"},
+		{"upcase", "{{ 'abc' | upcase }}", "ABC"},
+		{"escape", "{{ '<a href=\"x\">&</a>' | escape }}", "&lt;a href=&#34;x&#34;&gt;&amp;&lt;/a&gt;"},
+		{"strip", "[{{ ' \t a b \n' | strip }}]", "[a b]"},
+		{"lstrip", "[{{ '  a  ' | lstrip }}]", "[a  ]"},
+		{"rstrip", "[{{ '  a  ' | rstrip }}]", "[  a]"},
+		{"strip html", "{{ '<script>x</script><!-- c --><p>a</p><style>y</style>' | strip_html }}", "a"},
+		{"strip newlines", "{{ text | strip_newlines }}", "ab"},
+		{"newline to br", "{{ text | newline_to_br }}", "a<br />\nb"},
+		{"url encode", "{{ 'a b&c' | url_encode }}", "a+b%26c"},
+		{"replace", "{{ 'a-b-c' | replace: '-', '+' }}", "a+b+c"},
+		{"replace first", "{{ 'a-b-c' | replace_first: '-', '+' }}", "a+b-c"},
+		{"remove", "{{ 'a-b-c' | remove: '-' }}", "abc"},
+		{"remove first", "{{ 'a-b-c' | remove_first: '-' }}", "ab-c"},
+		{"truncate default", "{{ 'aaaaaaaaaabbbbbbbbbbccccccccccddddddddddeeeeeeeeeeff' | truncate }}", "aaaaaaaaaabbbbbbbbbbccccccccccddddddddddeeeeeee..."},
+		{"truncate ellipsis", "{{ 'Hello world' | truncate: 7, '!' }} {{ 'Hi' | truncate: 7 }} {{ 'Hello' | truncate: 2 }}", "Hello ! Hi ..."},
+		{"split", "{{ 'a b' | split: ' ' | size }} {{ '' | split: ',' | size }}", "2 0"},
+		{"join", "{{ numbers | join }} {{ 'a' | join: ',' }}", "1 2 3 a"},
+		{"size", "{{ 'héllo' | size }} {{ numbers | size }} {{ map | size }} {{ 5 | size }}", "5 3 1 0"},
+		{"first and last", "{{ numbers | first }} {{ numbers | last }} [{{ 'abc' | first }}]", "1 3 []"},
+		{"default", "{{ nothing | default: 'a' }} {{ '' | default: 'b' }} {{ false | default: 'c' }} {{ 'd' | default: 'e' }}", "a b c d"},
+		{"date", "{{ '2023-04-05T06:07:08Z' | date: '%b %d, %Y' }}", "Apr 05, 2023"},
+		{"plus and minus", "{{ 1 | plus: 2.5 }} {{ '3' | minus: 1 }}", "3.5 2"},
+		{"times", "{{ 1.5 | times: 2 }}", "3"},
+		{"divided by", "{{ 7 | divided_by: 2 }} {{ -7 | divided_by: 2 }} {{ 7.5 | divided_by: 2 }}", "3 -4 3.75"},
+		{"modulo", "{{ 7 | modulo: 3 }} {{ 7.5 | modulo: 2 }}", "1 1.5"},
+	}
+
+	variables := map[string]interface{}{
+		"text":    "a\nb",
+		"numbers": []int{1, 2, 3},
+		"map":     map[string]interface{}{"a": 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Render(testCase.template, variables)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result.Output)
+		})
+	}
+
+	var errorCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"missing argument", "{{ 'a' | append }}", `filter "append": expected 1 arguments, got 0`},
+		{"division by zero", "{{ 1 | divided_by: 0 }}", `filter "divided_by": division by zero`},
+		{"modulo by zero", "{{ 1 | modulo: 0 }}", `filter "modulo": division by zero`},
+	}
+
+	for _, testCase := range errorCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Render(testCase.template, nil)
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"unclosed output", "line\n{{ user.name", `line 2: "{{" is not closed`},
+		{"unclosed block", "{% if true %}\nyes", `line 1: "if" is not closed`},
+		{"unexpected end", "{% endif %}", `line 1: unexpected "endif"`},
+		{"mismatched end", "{% if true %}{% endfor %}", `line 1: unexpected "endfor" in "if"`},
+		{"unknown tag", "\n\n{% include 'header' %}", `line 3: unknown tag "include"`},
+		{"unknown filter", "{{ name | shout }}", `line 1: unknown filter "shout"`},
+		{"invalid expression", "{{ name name }}", `line 1: unexpected "name" in "name name"`},
+		{"unterminated string", "{{ 'name }}", `line 1: unterminated string in "'name"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testCase.template)
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+}
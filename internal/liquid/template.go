@@ -0,0 +1,757 @@
+package liquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxIterations is the maximum number of loop iterations and range values of a render, across
+	// all of its loops and ranges, as much as a single range can have.
+	maxIterations = maxRangeLength
+	// maxOutputSize is the maximum size in bytes of the output of a render, and of any string it builds,
+	// well above the size at which mail clients such as Gmail clip emails.
+	maxOutputSize = 256 << 10
+	// maxBuiltSize is the maximum size in bytes of all the strings built by the filters and captures
+	// of a render, which would otherwise be unbounded when they are used in loops.
+	maxBuiltSize = 8 << 20
+)
+
+var (
+	errOutputTooLarge = fmt.Errorf("output is larger than %d bytes", maxOutputSize)
+	errBuiltTooLarge  = fmt.Errorf("filters and captures built more than %d bytes", maxBuiltSize)
+)
+
+// Template is a parsed Liquid template.
+type Template struct {
+	nodes []node
+}
+
+// Result is the outcome of rendering a Template.
+type Result struct {
+	// The rendered template.
+	Output string
+	// The variables that were output but are not defined, sorted. Variables only used in
+	// conditions, or output with a `default` filter, are not reported.
+	Undefined []string
+}
+
+type node interface {
+	render(c *renderContext, b *strings.Builder) error
+}
+
+// Parse parses the source of a template.
+//
+// The subset of Liquid supported is the one available to Auth0 email templates: outputs with
+// filters, and the `if`, `elsif`, `else`, `unless`, `case`, `when`, `for`, `assign`, `capture`,
+// `comment` and `raw` tags.
+func Parse(source string) (*Template, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	nodes, end, err := p.parseUntil()
+	if err != nil {
+		return nil, err
+	}
+	if end != nil {
+		return nil, fmt.Errorf("line %d: unexpected %q", end.line, end.tag)
+	}
+
+	return &Template{nodes: nodes}, nil
+}
+
+// Render renders the template with the variables, which are normalized as if decoded from JSON.
+//
+// Rendering fails once the loops and ranges of the template go over 10,000 iterations in total,
+// once the output or a string built by the template goes over 256 KiB, or once the strings built by
+// filters and captures go over 8 MiB in total.
+func (t *Template) Render(variables map[string]interface{}) (*Result, error) {
+	normalized := map[string]interface{}{}
+	if variables != nil {
+		b, err := json.Marshal(variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the variables: %w", err)
+		}
+		if err := json.Unmarshal(b, &normalized); err != nil {
+			return nil, fmt.Errorf("failed to decode the variables: %w", err)
+		}
+	}
+
+	c := &renderContext{scopes: []map[string]interface{}{normalized, {}}, undefined: map[string]bool{}}
+	var b strings.Builder
+	if err := renderNodes(c, &b, t.nodes); err != nil {
+		return nil, err
+	}
+
+	result := &Result{Output: b.String()}
+	for name := range c.undefined {
+		result.Undefined = append(result.Undefined, name)
+	}
+	sort.Strings(result.Undefined)
+
+	return result, nil
+}
+
+// Render parses and renders the source of a template.
+func Render(source string, variables map[string]interface{}) (*Result, error) {
+	t, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	return t.Render(variables)
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parseUntil parses nodes until the end of the tokens, or until a tag closing or splitting a
+// block, which is returned.
+func (p *parser) parseUntil() ([]node, *token, error) {
+	var nodes []node
+	for p.pos < len(p.tokens) {
+		t := p.tokens[p.pos]
+		p.pos++
+
+		switch t.kind {
+		case tokenText:
+			nodes = append(nodes, textNode(t.value))
+		case tokenOutput:
+			e, err := parseExpression(t.value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", t.line, err)
+			}
+			nodes = append(nodes, &outputNode{e})
+		case tokenTag:
+			parse, ok := blockParsers[t.tag]
+			if !ok {
+				if isBlockBoundary(t.tag) {
+					return nodes, &t, nil
+				}
+				return nil, nil, fmt.Errorf("line %d: unknown tag %q", t.line, t.tag)
+			}
+			n, err := parse(p, t)
+			if err != nil {
+				return nil, nil, err
+			}
+			if n != nil {
+				nodes = append(nodes, n)
+			}
+		}
+	}
+	return nodes, nil, nil
+}
+
+// parseBlock parses nodes until one of the tags ends the block, failing at the end of the tokens.
+func (p *parser) parseBlock(open token, tags ...string) ([]node, *token, error) {
+	nodes, end, err := p.parseUntil()
+	if err != nil {
+		return nil, nil, err
+	}
+	if end == nil {
+		return nil, nil, fmt.Errorf("line %d: %q is not closed", open.line, open.tag)
+	}
+	for _, tag := range tags {
+		if end.tag == tag {
+			return nodes, end, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("line %d: unexpected %q in %q", end.line, end.tag, open.tag)
+}
+
+func isBlockBoundary(tag string) bool {
+	return strings.HasPrefix(tag, "end") || tag == "else" || tag == "elsif" || tag == "when"
+}
+
+var blockParsers map[string]func(p *parser, t token) (node, error)
+
+func init() {
+	blockParsers = map[string]func(p *parser, t token) (node, error){
+		"if":      parseIf,
+		"unless":  parseIf,
+		"case":    parseCase,
+		"for":     parseFor,
+		"assign":  parseAssign,
+		"capture": parseCapture,
+		"comment": parseComment,
+		"raw":     parseRaw,
+	}
+}
+
+type branch struct {
+	condition *condition
+	body      []node
+}
+
+type ifNode struct {
+	branches []branch
+	negate   bool
+	elseBody []node
+}
+
+func parseIf(p *parser, t token) (node, error) {
+	n := &ifNode{negate: t.tag == "unless"}
+	closing := "end" + t.tag
+
+	args := t.value
+	for {
+		c, err := parseCondition(args)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.line, err)
+		}
+		body, end, err := p.parseBlock(t, "elsif", "else", closing)
+		if err != nil {
+			return nil, err
+		}
+		n.branches = append(n.branches, branch{c, body})
+
+		switch end.tag {
+		case "elsif":
+			args = end.value
+			continue
+		case "else":
+			n.elseBody, _, err = p.parseBlock(t, closing)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	}
+}
+
+func (n *ifNode) render(c *renderContext, b *strings.Builder) error {
+	for i, br := range n.branches {
+		matched := br.condition.eval(c)
+		if i == 0 && n.negate {
+			matched = !matched
+		}
+		if matched {
+			return renderNodes(c, b, br.body)
+		}
+	}
+	return renderNodes(c, b, n.elseBody)
+}
+
+type when struct {
+	values []operand
+	body   []node
+}
+
+type caseNode struct {
+	value    operand
+	whens    []when
+	elseBody []node
+}
+
+func parseCase(p *parser, t token) (node, error) {
+	ep, err := newExprParser(t.value)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+	value, err := ep.operand()
+	if err == nil {
+		err = ep.expectEnd()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+
+	n := &caseNode{value: value}
+	// Text before the first `when` is ignored.
+	_, end, err := p.parseBlock(t, "when", "else", "endcase")
+	for err == nil {
+		switch end.tag {
+		case "when":
+			w := when{}
+			if w.values, err = parseWhenValues(end.value); err != nil {
+				return nil, fmt.Errorf("line %d: %w", end.line, err)
+			}
+			w.body, end, err = p.parseBlock(t, "when", "else", "endcase")
+			n.whens = append(n.whens, w)
+		case "else":
+			n.elseBody, end, err = p.parseBlock(t, "endcase")
+		case "endcase":
+			return n, nil
+		}
+	}
+	return nil, err
+}
+
+func parseWhenValues(s string) ([]operand, error) {
+	p, err := newExprParser(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []operand
+	for {
+		value, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if !p.accept(itemPunct, ",") && !p.accept(itemIdent, "or") {
+			return values, p.expectEnd()
+		}
+	}
+}
+
+func (n *caseNode) render(c *renderContext, b *strings.Builder) error {
+	value, _ := n.value.eval(c)
+	for _, w := range n.whens {
+		for _, candidate := range w.values {
+			other, _ := candidate.eval(c)
+			if equal(value, other) {
+				return renderNodes(c, b, w.body)
+			}
+		}
+	}
+	return renderNodes(c, b, n.elseBody)
+}
+
+type forNode struct {
+	name       string
+	collection operand
+	limit      operand
+	offset     operand
+	reversed   bool
+	body       []node
+	elseBody   []node
+}
+
+func parseFor(p *parser, t token) (node, error) {
+	ep, err := newExprParser(t.value)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+
+	n := &forNode{}
+	if n.name, err = ep.ident(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+	if !ep.accept(itemIdent, "in") {
+		return nil, fmt.Errorf("line %d: expected in after %q", t.line, n.name)
+	}
+	if n.collection, err = ep.operand(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+	for !ep.done() {
+		switch {
+		case ep.accept(itemIdent, "reversed"):
+			n.reversed = true
+		case ep.accept(itemIdent, "limit"):
+			if !ep.accept(itemPunct, ":") {
+				return nil, fmt.Errorf("line %d: expected : after limit", t.line)
+			}
+			n.limit, err = ep.operand()
+		case ep.accept(itemIdent, "offset"):
+			if !ep.accept(itemPunct, ":") {
+				return nil, fmt.Errorf("line %d: expected : after offset", t.line)
+			}
+			n.offset, err = ep.operand()
+		default:
+			err = ep.expectEnd()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.line, err)
+		}
+	}
+
+	body, end, err := p.parseBlock(t, "else", "endfor")
+	if err != nil {
+		return nil, err
+	}
+	n.body = body
+	if end.tag == "else" {
+		if n.elseBody, _, err = p.parseBlock(t, "endfor"); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+func (n *forNode) render(c *renderContext, b *strings.Builder) error {
+	// Ranges are iterated without building the list of their values.
+	var items []interface{}
+	var length int
+	item := func(i int) interface{} { return items[i] }
+	if r, ok := n.collection.(rangeOperand); ok {
+		from, rangeLength, _, err := r.bounds(c)
+		if err != nil {
+			return err
+		}
+		length = rangeLength
+		item = func(i int) interface{} { return from + float64(i) }
+	} else {
+		switch value := n.eval(c).(type) {
+		case []interface{}:
+			items = value
+		case map[string]interface{}:
+			keys := make([]string, 0, len(value))
+			for key := range value {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				items = append(items, []interface{}{key, value[key]})
+			}
+		}
+		length = len(items)
+	}
+
+	start := 0
+	if n.offset != nil {
+		offset, _ := n.offset.eval(c)
+		start = clamp(int(toNumber(offset)), length)
+		length -= start
+	}
+	if n.limit != nil {
+		limit, _ := n.limit.eval(c)
+		length = clamp(int(toNumber(limit)), length)
+	}
+
+	if length == 0 {
+		return renderNodes(c, b, n.elseBody)
+	}
+
+	c.push()
+	defer c.pop()
+	for i := 0; i < length; i++ {
+		index := start + i
+		if n.reversed {
+			index = start + length - 1 - i
+		}
+		if err := c.iterate(1); err != nil {
+			return err
+		}
+		c.set(n.name, item(index))
+		c.set("forloop", map[string]interface{}{
+			"index":   float64(i + 1),
+			"index0":  float64(i),
+			"rindex":  float64(length - i),
+			"rindex0": float64(length - i - 1),
+			"first":   i == 0,
+			"last":    i == length-1,
+			"length":  float64(length),
+		})
+		if err := renderNodes(c, b, n.body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eval returns the collection of the loop, recording it as undefined if it is an undefined variable.
+func (n *forNode) eval(c *renderContext) interface{} {
+	collection, defined := n.collection.eval(c)
+	if !defined {
+		if v, ok := n.collection.(*variable); ok {
+			c.undefined[v.String()] = true
+		}
+	}
+	return collection
+}
+
+// clamp limits n between 0 and max.
+func clamp(n, max int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+type assignNode struct {
+	name  string
+	value *expression
+}
+
+func parseAssign(_ *parser, t token) (node, error) {
+	name, value, ok := strings.Cut(t.value, "=")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("line %d: expected assign name = value", t.line)
+	}
+	e, err := parseExpression(value)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", t.line, err)
+	}
+	return &assignNode{name: name, value: e}, nil
+}
+
+func (n *assignNode) render(c *renderContext, _ *strings.Builder) error {
+	value, _, err := n.value.eval(c)
+	if err != nil {
+		return err
+	}
+	c.assign(n.name, value)
+	return nil
+}
+
+type captureNode struct {
+	name string
+	body []node
+}
+
+func parseCapture(p *parser, t token) (node, error) {
+	if t.value == "" {
+		return nil, fmt.Errorf("line %d: expected capture name", t.line)
+	}
+	body, _, err := p.parseBlock(t, "endcapture")
+	if err != nil {
+		return nil, err
+	}
+	return &captureNode{name: t.value, body: body}, nil
+}
+
+func (n *captureNode) render(c *renderContext, _ *strings.Builder) error {
+	var b strings.Builder
+	if err := renderNodes(c, &b, n.body); err != nil {
+		return err
+	}
+	if err := c.build(b.String()); err != nil {
+		return err
+	}
+	c.assign(n.name, b.String())
+	return nil
+}
+
+func parseComment(p *parser, t token) (node, error) {
+	// The content of comments is skipped without being parsed.
+	depth := 1
+	for ; p.pos < len(p.tokens); p.pos++ {
+		switch p.tokens[p.pos].tag {
+		case "comment":
+			depth++
+		case "endcomment":
+			depth--
+		}
+		if depth == 0 {
+			p.pos++
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("line %d: %q is not closed", t.line, t.tag)
+}
+
+func parseRaw(p *parser, t token) (node, error) {
+	// The lexer turns the content of raw tags into a single text token.
+	var text string
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenText {
+		text = p.tokens[p.pos].value
+		p.pos++
+	}
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].tag != "endraw" {
+		return nil, fmt.Errorf("line %d: %q is not closed", t.line, t.tag)
+	}
+	p.pos++
+	return textNode(text), nil
+}
+
+type textNode string
+
+func (n textNode) render(_ *renderContext, b *strings.Builder) error {
+	b.WriteString(string(n))
+	return nil
+}
+
+type outputNode struct {
+	expression *expression
+}
+
+func (n *outputNode) render(c *renderContext, b *strings.Builder) error {
+	value, defined, err := n.expression.eval(c)
+	if err != nil {
+		return err
+	}
+	if !defined && !n.expression.hasDefault() {
+		if v, ok := n.expression.operand.(*variable); ok {
+			c.undefined[v.String()] = true
+		}
+	}
+	b.WriteString(toString(value))
+	return nil
+}
+
+func renderNodes(c *renderContext, b *strings.Builder, nodes []node) error {
+	for _, n := range nodes {
+		if err := n.render(c, b); err != nil {
+			return err
+		}
+		if c.err != nil {
+			return c.err
+		}
+		if b.Len() > maxOutputSize {
+			return errOutputTooLarge
+		}
+	}
+	return nil
+}
+
+// renderContext holds the variables while rendering, in nested scopes.
+type renderContext struct {
+	// The variables passed to Render, then the ones assigned by the template, then the ones of loops.
+	scopes    []map[string]interface{}
+	undefined map[string]bool
+	// The first error of an operand, which cannot return errors, reported once its node is rendered.
+	err error
+	// The number of loop iterations and range values so far, limited to maxIterations.
+	iterations int
+	// The size of the strings built by filters and captures so far, limited to maxBuiltSize.
+	built int
+}
+
+// build counts a string built by a filter or a capture, failing if it is larger than maxOutputSize
+// or once the render goes over maxBuiltSize.
+func (c *renderContext) build(s string) error {
+	c.built += len(s)
+	if len(s) > maxOutputSize {
+		return errOutputTooLarge
+	}
+	if c.built > maxBuiltSize {
+		return errBuiltTooLarge
+	}
+	return nil
+}
+
+// iterate counts n iterations, failing once the render goes over maxIterations.
+func (c *renderContext) iterate(n int) error {
+	c.iterations += n
+	if c.iterations > maxIterations {
+		return fmt.Errorf("more than %d loop iterations", maxIterations)
+	}
+	return nil
+}
+
+func (c *renderContext) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *renderContext) lookup(name string) (interface{}, bool) {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if value, ok := c.scopes[i][name]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func (c *renderContext) push() {
+	c.scopes = append(c.scopes, map[string]interface{}{})
+}
+
+func (c *renderContext) pop() {
+	c.scopes = c.scopes[:len(c.scopes)-1]
+}
+
+func (c *renderContext) set(name string, value interface{}) {
+	c.scopes[len(c.scopes)-1][name] = value
+}
+
+// assign sets a variable of the template, visible after loops end.
+func (c *renderContext) assign(name string, value interface{}) {
+	c.scopes[1][name] = value
+}
+
+func (e *expression) eval(c *renderContext) (interface{}, bool, error) {
+	value, defined := e.operand.eval(c)
+	for _, f := range e.filters {
+		args := make([]interface{}, len(f.args))
+		for i, arg := range f.args {
+			args[i], _ = arg.eval(c)
+		}
+		var err error
+		if value, err = f.fn(value, args); err != nil {
+			return nil, false, fmt.Errorf("filter %q: %w", f.name, err)
+		}
+		if s, ok := value.(string); ok {
+			if err := c.build(s); err != nil {
+				return nil, false, fmt.Errorf("filter %q: %w", f.name, err)
+			}
+		}
+	}
+	return value, defined, nil
+}
+
+func (e *expression) hasDefault() bool {
+	for _, f := range e.filters {
+		if f.name == "default" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *condition) eval(rc *renderContext) bool {
+	result := c.comparisons[len(c.comparisons)-1].eval(rc)
+	for i := len(c.joins) - 1; i >= 0; i-- {
+		left := c.comparisons[i].eval(rc)
+		if c.joins[i] == "and" {
+			result = left && result
+		} else {
+			result = left || result
+		}
+	}
+	return result
+}
+
+func (cmp comparison) eval(c *renderContext) bool {
+	left, _ := cmp.left.eval(c)
+	if cmp.operator == "" {
+		return truthy(left)
+	}
+	right, _ := cmp.right.eval(c)
+
+	switch cmp.operator {
+	case "==":
+		return equal(left, right)
+	case "!=", "<>":
+		return !equal(left, right)
+	case "contains":
+		return contains(left, right)
+	}
+
+	if l, ok := left.(string); ok {
+		r, ok := right.(string)
+		if !ok {
+			return false
+		}
+		return compareOrdered(strings.Compare(l, r), cmp.operator)
+	}
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return false
+	}
+	switch {
+	case l < r:
+		return compareOrdered(-1, cmp.operator)
+	case l > r:
+		return compareOrdered(1, cmp.operator)
+	}
+	return compareOrdered(0, cmp.operator)
+}
+
+func compareOrdered(order int, operator string) bool {
+	switch operator {
+	case "<":
+		return order < 0
+	case ">":
+		return order > 0
+	case "<=":
+		return order <= 0
+	case ">=":
+		return order >= 0
+	}
+	return false
+}
@@ -11,6 +11,16 @@ const (
 	ActionTriggerPostLogin string = "post-login"
 	// ActionTriggerClientCredentials constant.
 	ActionTriggerClientCredentials string = "client-credentials"
+	// ActionTriggerCredentialsExchange constant.
+	ActionTriggerCredentialsExchange string = "credentials-exchange"
+	// ActionTriggerPreUserRegistration constant.
+	ActionTriggerPreUserRegistration string = "pre-user-registration"
+	// ActionTriggerPostUserRegistration constant.
+	ActionTriggerPostUserRegistration string = "post-user-registration"
+	// ActionTriggerPostChangePassword constant.
+	ActionTriggerPostChangePassword string = "post-change-password"
+	// ActionTriggerSendPhoneMessage constant.
+	ActionTriggerSendPhoneMessage string = "send-phone-message"
 )
 
 // ActionTrigger is part of a Flow.
@@ -185,6 +195,9 @@ type ActionExecutionResult struct {
 	ActionName *string                `json:"action_name,omitempty"`
 	Error      map[string]interface{} `json:"error,omitempty"`
 
+	// The outcome of the action, holding its error, logs and durations.
+	Response *ActionExecutionResponse `json:"response,omitempty"`
+
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	EndedAt   *time.Time `json:"ended_at,omitempty"`
 }
@@ -0,0 +1,283 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+// ActionExecutionError is the error an action failed with during an execution.
+type ActionExecutionError struct {
+	// The ID of the error, e.g. `invalid_argument`.
+	ID *string `json:"id,omitempty"`
+	// The message of the error.
+	Message *string `json:"msg,omitempty"`
+	// A link to the documentation of the error.
+	URL *string `json:"url,omitempty"`
+	// The name of the JavaScript error thrown by the action, e.g. `TypeError`.
+	Name *string `json:"name,omitempty"`
+	// The stack trace of the JavaScript error thrown by the action.
+	Stack *string `json:"stack,omitempty"`
+}
+
+// UnmarshalJSON is a custom deserializer for the ActionExecutionError type.
+//
+// It is required as the message of errors thrown by the code of an action is held by a `message` field.
+func (e *ActionExecutionError) UnmarshalJSON(data []byte) error {
+	type actionExecutionError ActionExecutionError
+	type actionExecutionErrorWrapper struct {
+		*actionExecutionError
+		RawMessage *string `json:"message,omitempty"`
+	}
+
+	alias := &actionExecutionErrorWrapper{actionExecutionError: (*actionExecutionError)(e)}
+	if err := json.Unmarshal(data, alias); err != nil {
+		return err
+	}
+
+	if e.Message == nil {
+		e.Message = alias.RawMessage
+	}
+
+	return nil
+}
+
+// ActionExecutionStats holds the durations of an action execution.
+type ActionExecutionStats struct {
+	// The time spent running the code of the action, in milliseconds.
+	ActionDuration *int `json:"action_duration_ms,omitempty"`
+	// The time spent booting the runtime of the action, in milliseconds.
+	BootDuration *int `json:"boot_duration_ms,omitempty"`
+	// The total time spent by the execution of the action, in milliseconds.
+	TotalRequestDuration *int `json:"total_request_duration_ms,omitempty"`
+	// The time spent running the action, including the runtime overhead, in milliseconds.
+	TotalRuntimeExecutionDuration *int `json:"total_runtime_execution_duration_ms,omitempty"`
+}
+
+// ActionExecutionResponse holds the outcome of the execution of an action.
+type ActionExecutionResponse struct {
+	// The error the action failed with, if any.
+	Error *ActionExecutionError `json:"error,omitempty"`
+	// The commands the action gave to the `api` object, as returned by the action runtime.
+	Data map[string]interface{} `json:"data,omitempty"`
+	// The console output of the action.
+	Logs *string `json:"logs,omitempty"`
+	// The durations of the execution.
+	Stats *ActionExecutionStats `json:"stats,omitempty"`
+}
+
+// ExecutionError returns the error the action failed with, or nil if it succeeded.
+func (r *ActionExecutionResult) ExecutionError() *ActionExecutionError {
+	if r.GetResponse().GetError() != nil {
+		return r.GetResponse().GetError()
+	}
+	rawError := r.GetError()
+	if len(rawError) == 0 {
+		return nil
+	}
+
+	var executionError ActionExecutionError
+	b, err := json.Marshal(rawError)
+	if err != nil {
+		return &ActionExecutionError{Message: auth0.String(fmt.Sprintf("%v", rawError))}
+	}
+	if err := json.Unmarshal(b, &executionError); err != nil {
+		return &ActionExecutionError{Message: auth0.String(string(b))}
+	}
+
+	return &executionError
+}
+
+// Failed returns true if the action failed during the execution.
+func (r *ActionExecutionResult) Failed() bool {
+	return r.ExecutionError() != nil
+}
+
+// Duration returns the time spent running the action, as reported by the action runtime or
+// otherwise measured between its start and end.
+func (r *ActionExecutionResult) Duration() time.Duration {
+	if stats := r.GetResponse().GetStats(); stats != nil && stats.ActionDuration != nil {
+		return time.Duration(stats.GetActionDuration()) * time.Millisecond
+	}
+	if r.GetStartedAt().IsZero() || r.GetEndedAt().IsZero() {
+		return 0
+	}
+	return r.GetEndedAt().Sub(r.GetStartedAt())
+}
+
+// Logs returns the console output of the action.
+func (r *ActionExecutionResult) Logs() string {
+	return r.GetResponse().GetLogs()
+}
+
+// ActionExecutionIDs returns the IDs of the action executions the log event relates to, found in
+// its details.
+func (l *Log) ActionExecutionIDs() []string {
+	actions, ok := l.Details["actions"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	executions, ok := actions["executions"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	ids := make([]string, 0, len(executions))
+	for _, execution := range executions {
+		if id, ok := execution.(string); ok && id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// ExecutionsForLog retrieves the action executions the log event relates to.
+func (m *ActionManager) ExecutionsForLog(ctx context.Context, l *Log, opts ...RequestOption) ([]*ActionExecution, error) {
+	var executions []*ActionExecution
+	for _, id := range l.ActionExecutionIDs() {
+		execution, err := m.Execution(ctx, id, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the execution %q of log %q: %w", id, l.GetLogID(), err)
+		}
+		executions = append(executions, execution)
+	}
+
+	return executions, nil
+}
+
+// ActionExecutionSummary holds the statistics of an action across a set of executions.
+type ActionExecutionSummary struct {
+	// The name of the action.
+	ActionName string
+	// The number of times the action ran.
+	Runs int
+	// The number of times the action failed.
+	Failures int
+	// The number of times the action ran for longer than the slow threshold.
+	SlowRuns int
+	// The average time spent running the action.
+	AverageDuration time.Duration
+	// The longest time spent running the action.
+	MaxDuration time.Duration
+	// The message of the last error the action failed with, in the order of the executions.
+	LastError string
+	// The IDs of the executions the action failed or was slow in.
+	ExecutionIDs []string
+}
+
+// ActionExecutionReport summarizes the failing and slow actions across a set of executions.
+type ActionExecutionReport struct {
+	// The duration above which an action run is considered slow.
+	SlowThreshold time.Duration
+	// The number of executions summarized.
+	Executions int
+	// The summary of every action, the ones failing the most first, then the slowest ones.
+	Actions []*ActionExecutionSummary
+}
+
+// SummarizeActionExecutions reports which actions fail, or run for longer than the slow threshold,
+// across the executions. Nil executions and results are skipped.
+func SummarizeActionExecutions(executions []*ActionExecution, slowThreshold time.Duration) *ActionExecutionReport {
+	report := &ActionExecutionReport{SlowThreshold: slowThreshold}
+	summaries := map[string]*ActionExecutionSummary{}
+	totalDurations := map[string]time.Duration{}
+
+	for _, execution := range executions {
+		if execution == nil {
+			continue
+		}
+		report.Executions++
+
+		for _, result := range execution.Results {
+			if result == nil {
+				continue
+			}
+
+			summary, ok := summaries[result.GetActionName()]
+			if !ok {
+				summary = &ActionExecutionSummary{ActionName: result.GetActionName()}
+				summaries[result.GetActionName()] = summary
+				report.Actions = append(report.Actions, summary)
+			}
+
+			duration := result.Duration()
+			summary.Runs++
+			totalDurations[summary.ActionName] += duration
+			if duration > summary.MaxDuration {
+				summary.MaxDuration = duration
+			}
+
+			failed, slow := result.Failed(), slowThreshold > 0 && duration > slowThreshold
+			if failed {
+				summary.Failures++
+				summary.LastError = result.ExecutionError().GetMessage()
+			}
+			if slow {
+				summary.SlowRuns++
+			}
+			if failed || slow {
+				summary.ExecutionIDs = append(summary.ExecutionIDs, execution.GetID())
+			}
+		}
+	}
+
+	for _, summary := range report.Actions {
+		summary.AverageDuration = totalDurations[summary.ActionName] / time.Duration(summary.Runs)
+	}
+
+	sort.SliceStable(report.Actions, func(i, j int) bool {
+		a, b := report.Actions[i], report.Actions[j]
+		if a.Failures != b.Failures {
+			return a.Failures > b.Failures
+		}
+		if a.SlowRuns != b.SlowRuns {
+			return a.SlowRuns > b.SlowRuns
+		}
+		return a.MaxDuration > b.MaxDuration
+	})
+
+	return report
+}
+
+// Problems returns the summaries of the actions that failed or were slow at least once.
+func (r *ActionExecutionReport) Problems() []*ActionExecutionSummary {
+	var problems []*ActionExecutionSummary
+	for _, summary := range r.Actions {
+		if summary.Failures > 0 || summary.SlowRuns > 0 {
+			problems = append(problems, summary)
+		}
+	}
+	return problems
+}
+
+// Table renders the report as a table, one row per action.
+func (r *ActionExecutionReport) Table() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d executions, slow above %s\n", r.Executions, r.SlowThreshold)
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tRUNS\tFAILURES\tSLOW\tAVG\tMAX\tLAST ERROR")
+	for _, summary := range r.Actions {
+		fmt.Fprintf(
+			w,
+			"%s\t%d\t%d\t%d\t%s\t%s\t%s\n",
+			summary.ActionName,
+			summary.Runs,
+			summary.Failures,
+			summary.SlowRuns,
+			summary.AverageDuration,
+			summary.MaxDuration,
+			summary.LastError,
+		)
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
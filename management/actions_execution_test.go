@@ -0,0 +1,173 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestActionExecutionResult(t *testing.T) {
+	var execution ActionExecution
+	err := json.Unmarshal([]byte(`{
+		"id": "exec_1",
+		"trigger_id": "post-login",
+		"status": "final",
+		"results": [
+			{
+				"action_name": "enrich-profile",
+				"started_at": "2023-10-01T12:00:00.000Z",
+				"ended_at": "2023-10-01T12:00:00.250Z",
+				"response": {"logs": "fetched profile\n", "stats": {"action_duration_ms": 120}}
+			},
+			{
+				"action_name": "check-plan",
+				"error": {"id": "invalid_argument", "msg": "plan is required", "url": "https://example.com/docs"},
+				"started_at": "2023-10-01T12:00:00.250Z",
+				"ended_at": "2023-10-01T12:00:00.300Z"
+			},
+			{
+				"action_name": "deny-blocked",
+				"started_at": "2023-10-01T12:00:00.300Z",
+				"ended_at": "2023-10-01T12:00:00.310Z",
+				"response": {"error": {"name": "TypeError", "message": "user is undefined", "stack": "TypeError: user is undefined\n    at onExecutePostLogin"}}
+			}
+		]
+	}`), &execution)
+	require.NoError(t, err)
+
+	enrich, check, deny := execution.Results[0], execution.Results[1], execution.Results[2]
+
+	assert.False(t, enrich.Failed())
+	assert.Nil(t, enrich.ExecutionError())
+	assert.Equal(t, 120*time.Millisecond, enrich.Duration())
+	assert.Equal(t, "fetched profile\n", enrich.Logs())
+
+	assert.True(t, check.Failed())
+	assert.Equal(t, &ActionExecutionError{
+		ID:      auth0.String("invalid_argument"),
+		Message: auth0.String("plan is required"),
+		URL:     auth0.String("https://example.com/docs"),
+	}, check.ExecutionError())
+	assert.Equal(t, 50*time.Millisecond, check.Duration())
+	assert.Empty(t, check.Logs())
+
+	assert.Equal(t, "TypeError", deny.ExecutionError().GetName())
+	assert.Equal(t, "user is undefined", deny.ExecutionError().GetMessage())
+	assert.Contains(t, deny.ExecutionError().GetStack(), "at onExecutePostLogin")
+}
+
+func TestLog_ActionExecutionIDs(t *testing.T) {
+	l := &Log{Details: map[string]interface{}{
+		"actions": map[string]interface{}{
+			"executions": []interface{}{"exec_1", "", "exec_2"},
+		},
+	}}
+
+	assert.Equal(t, []string{"exec_1", "exec_2"}, l.ActionExecutionIDs())
+	assert.Nil(t, (&Log{}).ActionExecutionIDs())
+	assert.Nil(t, (&Log{Details: map[string]interface{}{"actions": "none"}}).ActionExecutionIDs())
+}
+
+func TestActionManager_ExecutionsForLog(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/actions/executions/exec_1":
+			_, _ = w.Write([]byte(`{"id":"exec_1","trigger_id":"post-login"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"That execution does not exist."}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	executions, err := m.Action.ExecutionsForLog(context.Background(), &Log{Details: map[string]interface{}{
+		"actions": map[string]interface{}{"executions": []interface{}{"exec_1"}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, "exec_1", executions[0].GetID())
+
+	_, err = m.Action.ExecutionsForLog(context.Background(), &Log{
+		LogID: auth0.String("log_1"),
+		Details: map[string]interface{}{
+			"actions": map[string]interface{}{"executions": []interface{}{"exec_2"}},
+		},
+	})
+	assert.ErrorContains(t, err, `failed to retrieve the execution "exec_2" of log "log_1"`)
+	var managementErr Error
+	require.ErrorAs(t, err, &managementErr)
+	assert.Equal(t, http.StatusNotFound, managementErr.Status())
+}
+
+func TestSummarizeActionExecutions(t *testing.T) {
+	result := func(name string, durationMs int, errorMessage string) *ActionExecutionResult {
+		r := &ActionExecutionResult{
+			ActionName: auth0.String(name),
+			Response:   &ActionExecutionResponse{Stats: &ActionExecutionStats{ActionDuration: auth0.Int(durationMs)}},
+		}
+		if errorMessage != "" {
+			r.Error = map[string]interface{}{"msg": errorMessage}
+		}
+		return r
+	}
+
+	report := SummarizeActionExecutions([]*ActionExecution{
+		{ID: auth0.String("exec_1"), Results: []*ActionExecutionResult{result("fast", 10, ""), result("slow", 900, "")}},
+		{ID: auth0.String("exec_2"), Results: []*ActionExecutionResult{result("fast", 30, ""), result("slow", 100, "")}},
+		{ID: auth0.String("exec_3"), Results: []*ActionExecutionResult{result("failing", 20, "timeout"), result("slow", 700, "")}},
+	}, 500*time.Millisecond)
+
+	assert.Equal(t, 3, report.Executions)
+	assert.Equal(t, []*ActionExecutionSummary{
+		{
+			ActionName:      "failing",
+			Runs:            1,
+			Failures:        1,
+			AverageDuration: 20 * time.Millisecond,
+			MaxDuration:     20 * time.Millisecond,
+			LastError:       "timeout",
+			ExecutionIDs:    []string{"exec_3"},
+		},
+		{
+			ActionName:      "slow",
+			Runs:            3,
+			SlowRuns:        2,
+			AverageDuration: 566666666 * time.Nanosecond,
+			MaxDuration:     900 * time.Millisecond,
+			ExecutionIDs:    []string{"exec_1", "exec_3"},
+		},
+		{
+			ActionName:      "fast",
+			Runs:            2,
+			AverageDuration: 20 * time.Millisecond,
+			MaxDuration:     30 * time.Millisecond,
+		},
+	}, report.Actions)
+	assert.Len(t, report.Problems(), 2)
+	assert.Equal(t, "3 executions, slow above 500ms\n"+
+		"ACTION   RUNS  FAILURES  SLOW  AVG           MAX    LAST ERROR\n"+
+		"failing  1     1         0     20ms          20ms   timeout\n"+
+		"slow     3     0         2     566.666666ms  900ms  \n"+
+		"fast     2     0         0     20ms          30ms   \n", report.Table())
+
+	t.Run("Should skip nil entries and tolerate missing fields", func(t *testing.T) {
+		report := SummarizeActionExecutions([]*ActionExecution{
+			nil,
+			{Results: []*ActionExecutionResult{nil, {}}},
+		}, time.Second)
+
+		assert.Equal(t, 1, report.Executions)
+		assert.Equal(t, []*ActionExecutionSummary{{Runs: 1}}, report.Actions)
+	})
+}
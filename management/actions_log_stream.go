@@ -0,0 +1,272 @@
+package management
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultActionLogRenewBefore    = time.Minute
+	defaultActionLogReconnectDelay = time.Second
+	defaultActionLogBufferSize     = 100
+)
+
+// ActionLogLine is a line of output of an Action, received while tailing a log session.
+type ActionLogLine struct {
+	// The name of the action that produced the line.
+	ActionName *string `json:"action_name,omitempty"`
+	// The ID of the action that produced the line.
+	ActionID *string `json:"action_id,omitempty"`
+	// The ID of the execution of the trigger the line was produced in.
+	ExecutionID *string `json:"execution_id,omitempty"`
+	// The ID of the trigger the action was executed for.
+	TriggerID *string `json:"trigger_id,omitempty"`
+	// The level of the line, e.g. `log` or `error` depending on the console method called.
+	Level *string `json:"level,omitempty"`
+	// The console output of the action.
+	Message *string `json:"message,omitempty"`
+	// The time when the line was produced.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// ActionLogTailOptions configures how TailLogs streams the logs of Actions.
+type ActionLogTailOptions struct {
+	// Filters sent when creating log sessions, and applied to every line received. A line is
+	// streamed if it matches every filter on one of `action_name`, `action_id`, `execution_id`,
+	// `trigger_id` or `level`.
+	Filters []ActionLogSessionFilter
+	// How long before the log session expires a new one is created. Defaults to 1 minute.
+	RenewBefore time.Duration
+	// The time to wait before reconnecting when the stream ends unexpectedly. Defaults to 1 second.
+	ReconnectDelay time.Duration
+	// The number of lines buffered by the channel returned by Lines. Defaults to 100.
+	BufferSize int
+	// The client used to connect to the presigned URLs of the log sessions. Defaults to a client
+	// without timeout, as the connection is long-lived.
+	HTTPClient *http.Client
+}
+
+// ActionLogStream is a real-time stream of the logs of Actions, see TailLogs.
+type ActionLogStream struct {
+	lines  chan *ActionLogLine
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// Lines returns the channel the log lines are sent to. It is closed when the stream stops.
+func (s *ActionLogStream) Lines() <-chan *ActionLogLine {
+	return s.lines
+}
+
+// Err returns the error that stopped the stream, if any. It returns nil if the stream was
+// stopped by Close or by its context.
+func (s *ActionLogStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close stops the stream and waits for the Lines channel to be closed.
+func (s *ActionLogStream) Close() {
+	s.cancel()
+	for range s.lines {
+		// Drain the lines, so the stream is never blocked sending one.
+	}
+	<-s.done
+}
+
+// TailLogs creates a log session and streams the Actions logs it receives until the context is
+// done or Close is called. The log session is renewed before it expires, and the stream
+// reconnects when the connection is lost.
+//
+// See: https://auth0.com/docs/api/management/v2/#!/Actions/post_actions_log_sessions
+func (m *ActionManager) TailLogs(ctx context.Context, tailOptions ActionLogTailOptions, opts ...RequestOption) (*ActionLogStream, error) {
+	if tailOptions.RenewBefore <= 0 {
+		tailOptions.RenewBefore = defaultActionLogRenewBefore
+	}
+	if tailOptions.ReconnectDelay <= 0 {
+		tailOptions.ReconnectDelay = defaultActionLogReconnectDelay
+	}
+	if tailOptions.BufferSize <= 0 {
+		tailOptions.BufferSize = defaultActionLogBufferSize
+	}
+	if tailOptions.HTTPClient == nil {
+		tailOptions.HTTPClient = &http.Client{}
+	}
+
+	session := &ActionLogSession{Filters: tailOptions.Filters}
+	if err := m.LogSession(ctx, session, opts...); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &ActionLogStream{
+		lines:  make(chan *ActionLogLine, tailOptions.BufferSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(s.done)
+		defer close(s.lines)
+		defer cancel()
+
+		if err := s.run(ctx, m, session, tailOptions, opts...); err != nil && ctx.Err() == nil {
+			s.mu.Lock()
+			s.err = err
+			s.mu.Unlock()
+		}
+	}()
+
+	return s, nil
+}
+
+func (s *ActionLogStream) run(ctx context.Context, m *ActionManager, session *ActionLogSession, tailOptions ActionLogTailOptions, opts ...RequestOption) error {
+	for {
+		streamCtx, stop := context.WithCancel(ctx)
+		var renewal *time.Timer
+		if session.Expires != nil {
+			renewIn := time.Until(session.GetExpires()) - tailOptions.RenewBefore
+			if renewIn < tailOptions.ReconnectDelay {
+				renewIn = tailOptions.ReconnectDelay
+			}
+			renewal = time.AfterFunc(renewIn, stop)
+		}
+
+		err := s.read(streamCtx, session.GetURL(), tailOptions)
+		renewing := streamCtx.Err() != nil
+		if renewal != nil {
+			renewal.Stop()
+		}
+		stop()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		var statusErr *actionLogStatusError
+		if errors.As(err, &statusErr) {
+			return err
+		}
+
+		if !renewing {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(tailOptions.ReconnectDelay):
+			}
+		}
+
+		session = &ActionLogSession{Filters: tailOptions.Filters}
+		if err := m.LogSession(ctx, session, opts...); err != nil {
+			return fmt.Errorf("failed to renew the log session: %w", err)
+		}
+	}
+}
+
+type actionLogStatusError struct {
+	statusCode int
+}
+
+func (e *actionLogStatusError) Error() string {
+	return fmt.Sprintf("failed to connect to the log session: %d %s", e.statusCode, http.StatusText(e.statusCode))
+}
+
+// read parses the server-sent events of the log session until the stream ends, sending the
+// lines matching the filters.
+func (s *ActionLogStream) read(ctx context.Context, url string, tailOptions ActionLogTailOptions) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "text/event-stream")
+	request.Header.Set("Cache-Control", "no-cache")
+
+	response, err := tailOptions.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return &actionLogStatusError{statusCode: response.StatusCode}
+	}
+
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			if len(data) == 0 {
+				continue
+			}
+			logLine := parseActionLogLine(strings.Join(data, "\n"))
+			data = nil
+
+			if !logLine.matches(tailOptions.Filters) {
+				continue
+			}
+			select {
+			case s.lines <- logLine:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		case strings.HasPrefix(line, ":"):
+			// Comments are used by servers to keep the connection alive.
+		case line == "data" || strings.HasPrefix(line, "data:"):
+			value := strings.TrimPrefix(strings.TrimPrefix(line, "data"), ":")
+			data = append(data, strings.TrimPrefix(value, " "))
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseActionLogLine decodes the data of an event. Data that is not a JSON object is kept as the
+// message of the line.
+func parseActionLogLine(data string) *ActionLogLine {
+	var logLine ActionLogLine
+	if err := json.Unmarshal([]byte(data), &logLine); err != nil {
+		return &ActionLogLine{Message: &data}
+	}
+	return &logLine
+}
+
+func (l *ActionLogLine) matches(filters []ActionLogSessionFilter) bool {
+	for _, filter := range filters {
+		var value string
+		switch filter.Key {
+		case "action_name":
+			value = l.GetActionName()
+		case "action_id":
+			value = l.GetActionID()
+		case "execution_id":
+			value = l.GetExecutionID()
+		case "trigger_id":
+			value = l.GetTriggerID()
+		case "level":
+			value = l.GetLevel()
+		default:
+			// Filters on other keys are only applied by the log session.
+			continue
+		}
+		if value != filter.Val {
+			return false
+		}
+	}
+	return true
+}
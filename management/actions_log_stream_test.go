@@ -0,0 +1,156 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestActionManager_TailLogs(t *testing.T) {
+	t.Run("Should stream the log lines matching the filters", func(t *testing.T) {
+		server := newActionLogSessionServer(t, time.Hour, func(_ int, w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, ": keep-alive\n\n")
+			fmt.Fprint(w, `data: {"action_name":"my-action","execution_id":"exec_1","message":"hello","timestamp":"2023-10-01T12:00:00Z"}`+"\n\n")
+			fmt.Fprint(w, `data: {"action_name":"other-action","execution_id":"exec_1","message":"ignored"}`+"\n\n")
+			fmt.Fprint(w, "event: log\ndata: {\"action_name\":\"my-action\",\n")
+			fmt.Fprint(w, "data: \"message\":\"multi-line\"}\n\n")
+		})
+
+		stream, err := server.api.Action.TailLogs(context.Background(), ActionLogTailOptions{
+			Filters: []ActionLogSessionFilter{{Key: "action_name", Val: "my-action"}},
+		})
+		require.NoError(t, err)
+		t.Cleanup(stream.Close)
+
+		first := <-stream.Lines()
+		assert.Equal(t, "my-action", first.GetActionName())
+		assert.Equal(t, "exec_1", first.GetExecutionID())
+		assert.Equal(t, "hello", first.GetMessage())
+		assert.Equal(t, time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC), first.GetTimestamp())
+
+		second := <-stream.Lines()
+		assert.Equal(t, "multi-line", second.GetMessage())
+
+		assert.Equal(t, []ActionLogSessionFilter{{Key: "action_name", Val: "my-action"}}, server.sessions[0].Filters)
+	})
+
+	t.Run("Should renew the log session before it expires", func(t *testing.T) {
+		server := newActionLogSessionServer(t, time.Minute+50*time.Millisecond, func(session int, w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "data: from session %d\n\n", session)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		})
+
+		stream, err := server.api.Action.TailLogs(context.Background(), ActionLogTailOptions{
+			ReconnectDelay: time.Millisecond,
+		})
+		require.NoError(t, err)
+		t.Cleanup(stream.Close)
+
+		assert.Equal(t, "from session 1", (<-stream.Lines()).GetMessage())
+		assert.Equal(t, "from session 2", (<-stream.Lines()).GetMessage())
+	})
+
+	t.Run("Should reconnect when the stream ends", func(t *testing.T) {
+		server := newActionLogSessionServer(t, time.Hour, func(session int, w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, "data: from session %d\n\n", session)
+		})
+
+		stream, err := server.api.Action.TailLogs(context.Background(), ActionLogTailOptions{
+			ReconnectDelay: time.Millisecond,
+		})
+		require.NoError(t, err)
+		t.Cleanup(stream.Close)
+
+		assert.Equal(t, "from session 1", (<-stream.Lines()).GetMessage())
+		assert.Equal(t, "from session 2", (<-stream.Lines()).GetMessage())
+	})
+
+	t.Run("Should stop with an error when the log session is rejected", func(t *testing.T) {
+		server := newActionLogSessionServer(t, time.Hour, func(_ int, w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+
+		stream, err := server.api.Action.TailLogs(context.Background(), ActionLogTailOptions{})
+		require.NoError(t, err)
+
+		_, ok := <-stream.Lines()
+		assert.False(t, ok)
+		assert.EqualError(t, stream.Err(), "failed to connect to the log session: 403 Forbidden")
+	})
+
+	t.Run("Should stop without error when the context is done", func(t *testing.T) {
+		server := newActionLogSessionServer(t, time.Hour, func(_ int, w http.ResponseWriter, r *http.Request) {
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := server.api.Action.TailLogs(ctx, ActionLogTailOptions{})
+		require.NoError(t, err)
+
+		cancel()
+		_, ok := <-stream.Lines()
+		assert.False(t, ok)
+		assert.NoError(t, stream.Err())
+	})
+}
+
+type actionLogSessionServer struct {
+	api *Management
+
+	mu       sync.Mutex
+	sessions []*ActionLogSession
+}
+
+// newActionLogSessionServer starts a server creating log sessions that expire after expiresIn, and
+// tailing them with the handler.
+func newActionLogSessionServer(t *testing.T, expiresIn time.Duration, tail func(session int, w http.ResponseWriter, r *http.Request)) *actionLogSessionServer {
+	t.Helper()
+
+	server := &actionLogSessionServer{}
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+
+	mux.HandleFunc("/api/v2/actions/log-sessions", func(w http.ResponseWriter, r *http.Request) {
+		session := &ActionLogSession{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(session))
+
+		server.mu.Lock()
+		server.sessions = append(server.sessions, session)
+		session.URL = auth0.Stringf("%s/tail/%d", s.URL, len(server.sessions))
+		server.mu.Unlock()
+		session.Expires = auth0.Time(time.Now().Add(expiresIn))
+
+		w.WriteHeader(http.StatusCreated)
+		require.NoError(t, json.NewEncoder(w).Encode(session))
+	})
+	mux.HandleFunc("/tail/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		var session int
+		_, err := fmt.Sscanf(r.URL.Path, "/tail/%d", &session)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		tail(session, w, r)
+	})
+
+	t.Cleanup(s.Close)
+
+	var err error
+	server.api, err = New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	return server
+}
@@ -0,0 +1,353 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+const (
+	defaultActionBuildInterval = time.Second
+	defaultActionBuildTimeout  = 5 * time.Minute
+)
+
+// ActionBuildError is returned when an action, or a version of an action, fails to build.
+type ActionBuildError struct {
+	// ID of the action that failed to build.
+	ActionID string
+	// ID of the version that failed to build, empty if the draft of the action failed to build.
+	VersionID string
+	// Status of the action or version, usually `failed`.
+	Status string
+	// Errors reported by the build.
+	Errors []*ActionVersionError
+}
+
+// Error implements the error interface.
+func (e *ActionBuildError) Error() string {
+	subject := fmt.Sprintf("action %q", e.ActionID)
+	if e.VersionID != "" {
+		subject = fmt.Sprintf("version %q of action %q", e.VersionID, e.ActionID)
+	}
+
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("%s failed to build, its status is %q", subject, e.Status)
+	}
+
+	messages := make([]string, 0, len(e.Errors))
+	for _, versionError := range e.Errors {
+		messages = append(messages, versionError.GetMessage())
+	}
+
+	return fmt.Sprintf("%s failed to build: %s", subject, strings.Join(messages, "; "))
+}
+
+// ActionPublishOptions configures how Publish deploys and binds an action.
+type ActionPublishOptions struct {
+	// Path of a file holding the source code of the action. When set, it replaces the code of the action.
+	CodeFile string
+	// Path of a package.json file. When set, its dependencies replace the dependencies of the action.
+	PackageFile string
+	// Zero based position of the action in the binding order of its trigger. Positions past the end of
+	// the binding order are clamped. When nil, an action already bound keeps its position, otherwise it
+	// is bound last.
+	Position *int
+	// Display name of the binding. Defaults to the display name of the current binding, or the name of
+	// the action.
+	DisplayName string
+	// Whether to deploy the previously deployed version of the action again if publishing fails after
+	// the new version was deployed.
+	Rollback bool
+	// The time to wait between build status checks. Defaults to 1 second.
+	BuildInterval time.Duration
+	// The maximum time to wait for the action, and then its new version, to be built. Defaults to 5 minutes.
+	BuildTimeout time.Duration
+}
+
+// Publish creates or updates an action, waits for it to be built, deploys it and binds it to its
+// trigger in a single call.
+//
+// The action is updated if it has an ID, or if an action with the same name exists, and created
+// otherwise. Its first supported trigger is the one it gets bound to, while the bindings of the
+// other actions of the trigger are kept in order. Build failures are returned as an *ActionBuildError.
+//
+// Publish is not atomic: the action is saved and its new version deployed before it is bound, so a
+// failure can leave the action updated or the new version deployed. With Rollback, the previously
+// deployed version is deployed again if the new version fails to build or to be bound, but the saved
+// code and dependencies of the action are kept.
+func (m *ActionManager) Publish(ctx context.Context, a *Action, publishOptions ActionPublishOptions, opts ...RequestOption) (*ActionVersion, error) {
+	if len(a.SupportedTriggers) == 0 {
+		return nil, fmt.Errorf("action %q has no supported trigger to bind to", a.GetName())
+	}
+	triggerID := a.SupportedTriggers[0].GetID()
+
+	if err := publishOptions.loadSources(a); err != nil {
+		return nil, err
+	}
+
+	previousVersion, err := m.save(ctx, a, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.waitForActionBuilt(ctx, a.GetID(), publishOptions, opts...); err != nil {
+		return nil, err
+	}
+
+	version, err := m.Deploy(ctx, a.GetID(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := func(err error) error {
+		if !publishOptions.Rollback || previousVersion.GetID() == "" {
+			return err
+		}
+		if _, rollbackErr := m.DeployVersion(ctx, a.GetID(), previousVersion.GetID(), opts...); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back action %q to version %q: %w", a.GetID(), previousVersion.GetID(), rollbackErr))
+		}
+		return err
+	}
+
+	version, err = m.waitForVersionBuilt(ctx, a.GetID(), version, publishOptions, opts...)
+	if err != nil {
+		return version, rollback(err)
+	}
+
+	if err := m.bind(ctx, triggerID, a, publishOptions, opts...); err != nil {
+		return version, rollback(err)
+	}
+
+	return version, nil
+}
+
+// loadSources reads the code and dependencies of the action from local files.
+func (o ActionPublishOptions) loadSources(a *Action) error {
+	if o.CodeFile != "" {
+		code, err := os.ReadFile(o.CodeFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the code of action %q: %w", a.GetName(), err)
+		}
+		a.Code = auth0.String(string(code))
+	}
+
+	if o.PackageFile != "" {
+		content, err := os.ReadFile(o.PackageFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the dependencies of action %q: %w", a.GetName(), err)
+		}
+
+		var pkg struct {
+			Dependencies map[string]string `json:"dependencies"`
+		}
+		if err := json.Unmarshal(content, &pkg); err != nil {
+			return fmt.Errorf("failed to parse %q: %w", o.PackageFile, err)
+		}
+
+		dependencies := make([]ActionDependency, 0, len(pkg.Dependencies))
+		for name, version := range pkg.Dependencies {
+			dependencies = append(dependencies, ActionDependency{Name: auth0.String(name), Version: auth0.String(version)})
+		}
+		sort.Slice(dependencies, func(i, j int) bool {
+			return dependencies[i].GetName() < dependencies[j].GetName()
+		})
+		a.Dependencies = &dependencies
+	}
+
+	return nil
+}
+
+// save updates the action if it exists, or creates it, and returns the version that was deployed
+// before the action was saved.
+func (m *ActionManager) save(ctx context.Context, a *Action, opts ...RequestOption) (*ActionVersion, error) {
+	existing, err := m.findExisting(ctx, a, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		if err := m.Create(ctx, a, opts...); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	update := &Action{
+		Name:              a.Name,
+		SupportedTriggers: a.SupportedTriggers,
+		Code:              a.Code,
+		Dependencies:      a.Dependencies,
+		Runtime:           a.Runtime,
+		Secrets:           a.Secrets,
+	}
+	if err := m.Update(ctx, existing.GetID(), update, opts...); err != nil {
+		return nil, err
+	}
+	// The ID cannot be sent in the update, so it is set from the existing action.
+	*a = *update
+	a.ID = existing.ID
+
+	return existing.DeployedVersion, nil
+}
+
+func (m *ActionManager) findExisting(ctx context.Context, a *Action, opts ...RequestOption) (*Action, error) {
+	if a.GetID() != "" {
+		return m.Read(ctx, a.GetID(), opts...)
+	}
+
+	actions, err := m.List(ctx, append(opts, Parameter("actionName", a.GetName()))...)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions.Actions {
+		if action.GetName() == a.GetName() {
+			return action, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *ActionManager) waitForActionBuilt(ctx context.Context, id string, publishOptions ActionPublishOptions, opts ...RequestOption) error {
+	return publishOptions.poll(ctx, func() (bool, error) {
+		a, err := m.Read(ctx, id, opts...)
+		if err != nil {
+			return false, err
+		}
+
+		switch a.GetStatus() {
+		case ActionStatusBuilt:
+			return true, nil
+		case ActionStatusFailed:
+			return false, &ActionBuildError{ActionID: id, Status: a.GetStatus()}
+		}
+
+		return false, nil
+	})
+}
+
+func (m *ActionManager) waitForVersionBuilt(ctx context.Context, id string, v *ActionVersion, publishOptions ActionPublishOptions, opts ...RequestOption) (*ActionVersion, error) {
+	err := publishOptions.poll(ctx, func() (bool, error) {
+		switch v.GetStatus() {
+		case ActionStatusBuilt:
+			return true, nil
+		case ActionStatusFailed:
+			return false, &ActionBuildError{ActionID: id, VersionID: v.GetID(), Status: v.GetStatus(), Errors: v.Errors}
+		}
+
+		latest, err := m.Version(ctx, id, v.GetID(), opts...)
+		if err != nil {
+			return false, err
+		}
+		v = latest
+
+		return false, nil
+	})
+
+	return v, err
+}
+
+// poll calls done until it returns true or an error, waiting BuildInterval between calls.
+func (o ActionPublishOptions) poll(ctx context.Context, done func() (bool, error)) error {
+	interval := o.BuildInterval
+	if interval <= 0 {
+		interval = defaultActionBuildInterval
+	}
+	timeout := o.BuildTimeout
+	if timeout <= 0 {
+		timeout = defaultActionBuildTimeout
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return fmt.Errorf("timed out waiting for the action to be built: %w", context.DeadlineExceeded)
+		case <-ticker.C:
+		}
+	}
+}
+
+// bind inserts the action in the binding order of the trigger, keeping the bindings of the other
+// actions. The bindings are left untouched if the action is already bound at the requested position.
+func (m *ActionManager) bind(ctx context.Context, triggerID string, a *Action, publishOptions ActionPublishOptions, opts ...RequestOption) error {
+	var current []*ActionBinding
+	for page := 0; ; page++ {
+		bindings, err := m.Bindings(ctx, triggerID, append(append([]RequestOption{}, opts...), Page(page))...)
+		if err != nil {
+			return err
+		}
+		current = append(current, bindings.Bindings...)
+		if len(bindings.Bindings) == 0 || !bindings.HasNext() {
+			break
+		}
+	}
+
+	position := len(current)
+	displayName := publishOptions.DisplayName
+	bindings := make([]*ActionBinding, 0, len(current)+1)
+	for i, binding := range current {
+		if binding.GetAction().GetID() == a.GetID() {
+			position = i
+			if displayName == "" {
+				displayName = binding.GetDisplayName()
+			}
+			continue
+		}
+		bindings = append(bindings, &ActionBinding{
+			Ref: &ActionBindingReference{
+				Type:  auth0.String(ActionBindingReferenceByID),
+				Value: binding.GetAction().ID,
+			},
+			DisplayName: binding.DisplayName,
+		})
+	}
+	if publishOptions.Position != nil {
+		position = *publishOptions.Position
+	}
+	if position < 0 {
+		position = 0
+	}
+	if position > len(bindings) {
+		position = len(bindings)
+	}
+	if displayName == "" {
+		displayName = a.GetName()
+	}
+
+	if position < len(current) &&
+		current[position].GetAction().GetID() == a.GetID() &&
+		current[position].GetDisplayName() == displayName &&
+		len(current) == len(bindings)+1 {
+		return nil
+	}
+
+	binding := &ActionBinding{
+		Ref: &ActionBindingReference{
+			Type:  auth0.String(ActionBindingReferenceByID),
+			Value: a.ID,
+		},
+		DisplayName: auth0.String(displayName),
+	}
+	bindings = append(bindings[:position], append([]*ActionBinding{binding}, bindings[position:]...)...)
+
+	return m.UpdateBindings(ctx, triggerID, bindings, opts...)
+}
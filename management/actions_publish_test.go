@@ -0,0 +1,264 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestActionManager_Publish(t *testing.T) {
+	publishOptions := ActionPublishOptions{
+		BuildInterval: time.Millisecond,
+		BuildTimeout:  time.Second,
+	}
+
+	t.Run("Should update, deploy and bind an existing action at a position", func(t *testing.T) {
+		server := newActionPublishServer(t)
+		server.existing = &Action{
+			ID:              auth0.String("act_2"),
+			Name:            auth0.String("My Action"),
+			DeployedVersion: &ActionVersion{ID: auth0.String("ver_1")},
+		}
+		server.bindings = []*ActionBinding{
+			{Action: &Action{ID: auth0.String("act_1")}, DisplayName: auth0.String("First")},
+			{Action: &Action{ID: auth0.String("act_3")}, DisplayName: auth0.String("Last")},
+		}
+
+		dir := t.TempDir()
+		codeFile := filepath.Join(dir, "index.js")
+		packageFile := filepath.Join(dir, "package.json")
+		require.NoError(t, os.WriteFile(codeFile, []byte("exports.onExecutePostLogin = async () => {};"), 0o600))
+		require.NoError(t, os.WriteFile(packageFile, []byte(`{"dependencies":{"uuid":"9.0.0","lodash":"4.17.21"}}`), 0o600))
+
+		options := publishOptions
+		options.CodeFile = codeFile
+		options.PackageFile = packageFile
+		options.Position = auth0.Int(1)
+
+		action := givenAPublishableAction()
+		version, err := server.api.Action.Publish(context.Background(), action, options)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ver_2", version.GetID())
+		assert.Equal(t, ActionStatusBuilt, version.GetStatus())
+		assert.Equal(t, "act_2", action.GetID())
+		assert.Equal(t, "exports.onExecutePostLogin = async () => {};", server.saved.GetCode())
+		assert.Equal(t, []ActionDependency{
+			{Name: auth0.String("lodash"), Version: auth0.String("4.17.21")},
+			{Name: auth0.String("uuid"), Version: auth0.String("9.0.0")},
+		}, *server.saved.Dependencies)
+		assert.Equal(t, []string{"act_1:First", "act_2:My Action", "act_3:Last"}, server.updatedBindings)
+		assert.Empty(t, server.rolledBackTo)
+	})
+
+	t.Run("Should create an action and bind it last", func(t *testing.T) {
+		server := newActionPublishServer(t)
+		server.bindings = []*ActionBinding{
+			{Action: &Action{ID: auth0.String("act_1")}, DisplayName: auth0.String("First")},
+		}
+
+		options := publishOptions
+		options.DisplayName = "Published"
+
+		action := givenAPublishableAction()
+		_, err := server.api.Action.Publish(context.Background(), action, options)
+
+		require.NoError(t, err)
+		assert.Equal(t, "act_2", action.GetID())
+		assert.True(t, server.created)
+		assert.Equal(t, []string{"act_1:First", "act_2:Published"}, server.updatedBindings)
+	})
+
+	t.Run("Should not update the bindings if the action is already in place", func(t *testing.T) {
+		server := newActionPublishServer(t)
+		server.existing = &Action{ID: auth0.String("act_2"), Name: auth0.String("My Action")}
+		server.bindings = []*ActionBinding{
+			{Action: &Action{ID: auth0.String("act_1")}, DisplayName: auth0.String("First")},
+			{Action: &Action{ID: auth0.String("act_2")}, DisplayName: auth0.String("Mine")},
+		}
+
+		_, err := server.api.Action.Publish(context.Background(), givenAPublishableAction(), publishOptions)
+
+		require.NoError(t, err)
+		assert.Nil(t, server.updatedBindings)
+	})
+
+	t.Run("Should surface build errors and roll back to the previous version", func(t *testing.T) {
+		server := newActionPublishServer(t)
+		server.existing = &Action{
+			ID:              auth0.String("act_2"),
+			Name:            auth0.String("My Action"),
+			DeployedVersion: &ActionVersion{ID: auth0.String("ver_1")},
+		}
+		server.versionErrors = []*ActionVersionError{
+			{ID: auth0.String("invalid_dependency"), Message: auth0.String("lodash@0.0.0 not found")},
+		}
+
+		options := publishOptions
+		options.Rollback = true
+
+		_, err := server.api.Action.Publish(context.Background(), givenAPublishableAction(), options)
+
+		var buildErr *ActionBuildError
+		require.True(t, errors.As(err, &buildErr))
+		assert.Equal(t, "ver_2", buildErr.VersionID)
+		assert.Equal(t, ActionStatusFailed, buildErr.Status)
+		assert.EqualError(t, err, `version "ver_2" of action "act_2" failed to build: lodash@0.0.0 not found`)
+		assert.Equal(t, "ver_1", server.rolledBackTo)
+		assert.Nil(t, server.updatedBindings)
+	})
+
+	t.Run("Should fail without a trigger", func(t *testing.T) {
+		server := newActionPublishServer(t)
+
+		_, err := server.api.Action.Publish(context.Background(), &Action{Name: auth0.String("My Action")}, publishOptions)
+
+		assert.EqualError(t, err, `action "My Action" has no supported trigger to bind to`)
+	})
+}
+
+func givenAPublishableAction() *Action {
+	return &Action{
+		Name: auth0.String("My Action"),
+		Code: auth0.String("exports.onExecutePostLogin = async (event, api) => {};"),
+		SupportedTriggers: []ActionTrigger{
+			{
+				ID:      auth0.String(ActionTriggerPostLogin),
+				Version: auth0.String("v3"),
+			},
+		},
+	}
+}
+
+// actionPublishServer fakes the Actions endpoints used by Publish. Actions and versions report a
+// `building` status once before they are built, or fail to build if versionErrors is set.
+type actionPublishServer struct {
+	api *Management
+
+	existing      *Action
+	bindings      []*ActionBinding
+	versionErrors []*ActionVersionError
+
+	created         bool
+	saved           *Action
+	updatedBindings []string
+	rolledBackTo    string
+}
+
+func newActionPublishServer(t *testing.T) *actionPublishServer {
+	t.Helper()
+
+	server := &actionPublishServer{}
+	var actionReads, versionReads int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/actions/actions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "My Action", r.URL.Query().Get("actionName"))
+			actions := &ActionList{}
+			if server.existing != nil {
+				actions.Actions = []*Action{server.existing}
+			}
+			writeJSON(t, w, actions)
+		case http.MethodPost:
+			server.created = true
+			server.saved = readActionBody(t, r)
+			server.saved.ID = auth0.String("act_2")
+			w.WriteHeader(http.StatusCreated)
+			writeJSON(t, w, server.saved)
+		}
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			actionReads++
+			status := ActionStatusBuilding
+			if actionReads > 1 {
+				status = ActionStatusBuilt
+			}
+			writeJSON(t, w, &Action{ID: auth0.String("act_2"), Status: auth0.String(status)})
+		case http.MethodPatch:
+			server.saved = readActionBody(t, r)
+			assert.Nil(t, server.saved.ID)
+			// The response has no ID, for Publish not to depend on it.
+			writeJSON(t, w, server.saved)
+		}
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_2/deploy", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &ActionVersion{ID: auth0.String("ver_2"), Status: auth0.String(ActionStatusPending)})
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_2/versions/ver_2", func(w http.ResponseWriter, r *http.Request) {
+		versionReads++
+		version := &ActionVersion{ID: auth0.String("ver_2"), Status: auth0.String(ActionStatusBuilding)}
+		if versionReads > 1 {
+			version.Status = auth0.String(ActionStatusBuilt)
+			if server.versionErrors != nil {
+				version.Status = auth0.String(ActionStatusFailed)
+				version.Errors = server.versionErrors
+			}
+		}
+		writeJSON(t, w, version)
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_2/versions/ver_1/deploy", func(w http.ResponseWriter, r *http.Request) {
+		server.rolledBackTo = "ver_1"
+		writeJSON(t, w, &ActionVersion{ID: auth0.String("ver_1")})
+	})
+	mux.HandleFunc("/api/v2/actions/triggers/post-login/bindings", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			// Serve one binding per page to exercise the paging.
+			page, err := strconv.Atoi(r.URL.Query().Get("page"))
+			require.NoError(t, err)
+			bindings := &ActionBindingList{List: List{Start: page, Limit: 1, Total: len(server.bindings)}}
+			if page < len(server.bindings) {
+				bindings.Bindings = server.bindings[page : page+1]
+			}
+			writeJSON(t, w, bindings)
+		case http.MethodPatch:
+			var payload actionBindingsPerTrigger
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			server.updatedBindings = []string{}
+			for _, binding := range payload.Bindings {
+				assert.Equal(t, ActionBindingReferenceByID, binding.GetRef().GetType())
+				server.updatedBindings = append(server.updatedBindings, binding.GetRef().GetValue()+":"+binding.GetDisplayName())
+			}
+			writeJSON(t, w, &actionBindingsPerTrigger{})
+		}
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	var err error
+	server.api, err = New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	return server
+}
+
+func readActionBody(t *testing.T, r *http.Request) *Action {
+	t.Helper()
+
+	var a Action
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&a))
+	return &a
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	t.Helper()
+
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
@@ -0,0 +1,226 @@
+package actiontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Command is a call an Action made on the `api` object, e.g. `api.user.setAppMetadata("plan", "pro")`
+// is recorded as a Command named `user.setAppMetadata` with the arguments `plan` and `pro`.
+type Command struct {
+	Name string        `json:"name"`
+	Args []interface{} `json:"args,omitempty"`
+}
+
+// String returns the command as it reads in the code of an Action.
+func (c Command) String() string {
+	args := make([]string, 0, len(c.Args))
+	for _, arg := range c.Args {
+		b, err := json.Marshal(arg)
+		if err != nil {
+			b = []byte(fmt.Sprintf("%v", arg))
+		}
+		args = append(args, string(b))
+	}
+	return fmt.Sprintf("api.%s(%s)", c.Name, strings.Join(args, ", "))
+}
+
+// API models the `api` object an Action receives, recording the commands it is given so that they
+// can be compared with the ones recorded by a test execution using Diff.
+//
+// Commands that are not available to a trigger are rejected when the Action runs, so only use the
+// ones documented for the trigger under test.
+type API struct {
+	commands *[]Command
+
+	Access         AccessAPI
+	AccessToken    AccessTokenAPI
+	Authentication AuthenticationAPI
+	IDToken        IDTokenAPI
+	Multifactor    MultifactorAPI
+	Redirect       RedirectAPI
+	User           UserAPI
+}
+
+// NewAPI returns an API without any recorded command.
+func NewAPI() *API {
+	commands := &[]Command{}
+	return &API{
+		commands:       commands,
+		Access:         AccessAPI{commands},
+		AccessToken:    AccessTokenAPI{commands},
+		Authentication: AuthenticationAPI{commands},
+		IDToken:        IDTokenAPI{commands},
+		Multifactor:    MultifactorAPI{commands},
+		Redirect:       RedirectAPI{commands},
+		User:           UserAPI{commands},
+	}
+}
+
+// Commands returns the recorded commands, with their arguments normalized the way they are once
+// decoded from JSON. It fails if an argument cannot be encoded as JSON.
+func (a *API) Commands() ([]Command, error) {
+	var commands []Command
+	b, err := json.Marshal(*a.commands)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the recorded commands: %w", err)
+	}
+	if err := json.Unmarshal(b, &commands); err != nil {
+		return nil, fmt.Errorf("failed to decode the recorded commands: %w", err)
+	}
+	return commands, nil
+}
+
+func record(commands *[]Command, name string, args ...interface{}) {
+	*commands = append(*commands, Command{Name: name, Args: args})
+}
+
+// AccessAPI models `api.access`.
+type AccessAPI struct{ commands *[]Command }
+
+// Deny records `api.access.deny(reason)`, available to the `post-login` trigger.
+func (a AccessAPI) Deny(reason string) {
+	record(a.commands, "access.deny", reason)
+}
+
+// DenyWithCode records `api.access.deny(code, reason)`, available to the `credentials-exchange` trigger.
+func (a AccessAPI) DenyWithCode(code, reason string) {
+	record(a.commands, "access.deny", code, reason)
+}
+
+// DenyWithUserMessage records `api.access.deny(reason, userMessage)`, available to the
+// `pre-user-registration` trigger.
+func (a AccessAPI) DenyWithUserMessage(reason, userMessage string) {
+	record(a.commands, "access.deny", reason, userMessage)
+}
+
+// AccessTokenAPI models `api.accessToken`.
+type AccessTokenAPI struct{ commands *[]Command }
+
+// SetCustomClaim records `api.accessToken.setCustomClaim(name, value)`.
+func (a AccessTokenAPI) SetCustomClaim(name string, value interface{}) {
+	record(a.commands, "accessToken.setCustomClaim", name, value)
+}
+
+// AddScope records `api.accessToken.addScope(scope)`.
+func (a AccessTokenAPI) AddScope(scope string) {
+	record(a.commands, "accessToken.addScope", scope)
+}
+
+// RemoveScope records `api.accessToken.removeScope(scope)`.
+func (a AccessTokenAPI) RemoveScope(scope string) {
+	record(a.commands, "accessToken.removeScope", scope)
+}
+
+// AuthenticationAPI models `api.authentication`.
+type AuthenticationAPI struct{ commands *[]Command }
+
+// RecordMethod records `api.authentication.recordMethod(providerURL)`.
+func (a AuthenticationAPI) RecordMethod(providerURL string) {
+	record(a.commands, "authentication.recordMethod", providerURL)
+}
+
+// IDTokenAPI models `api.idToken`.
+type IDTokenAPI struct{ commands *[]Command }
+
+// SetCustomClaim records `api.idToken.setCustomClaim(name, value)`.
+func (a IDTokenAPI) SetCustomClaim(name string, value interface{}) {
+	record(a.commands, "idToken.setCustomClaim", name, value)
+}
+
+// MultifactorAPI models `api.multifactor`.
+type MultifactorAPI struct{ commands *[]Command }
+
+// Enable records `api.multifactor.enable(provider)`.
+func (a MultifactorAPI) Enable(provider string) {
+	record(a.commands, "multifactor.enable", provider)
+}
+
+// RedirectAPI models `api.redirect`.
+type RedirectAPI struct{ commands *[]Command }
+
+// SendUserTo records `api.redirect.sendUserTo(url, { query })`.
+func (a RedirectAPI) SendUserTo(url string, query map[string]string) {
+	if query == nil {
+		record(a.commands, "redirect.sendUserTo", url)
+		return
+	}
+	record(a.commands, "redirect.sendUserTo", url, map[string]interface{}{"query": query})
+}
+
+// UserAPI models `api.user`.
+type UserAPI struct{ commands *[]Command }
+
+// SetAppMetadata records `api.user.setAppMetadata(name, value)`.
+func (a UserAPI) SetAppMetadata(name string, value interface{}) {
+	record(a.commands, "user.setAppMetadata", name, value)
+}
+
+// SetUserMetadata records `api.user.setUserMetadata(name, value)`.
+func (a UserAPI) SetUserMetadata(name string, value interface{}) {
+	record(a.commands, "user.setUserMetadata", name, value)
+}
+
+const (
+	// DiffMissing marks an expected command that was not recorded.
+	DiffMissing = "-"
+	// DiffUnexpected marks a recorded command that was not expected.
+	DiffUnexpected = "+"
+)
+
+// CommandDiff is a difference between expected and recorded commands.
+type CommandDiff struct {
+	// Either DiffMissing or DiffUnexpected.
+	Op string
+	// Position of the command in the expected commands if missing, or in the recorded commands otherwise.
+	Index int
+	// The command that differs.
+	Command Command
+}
+
+// String returns the difference as a line of a unified diff.
+func (d CommandDiff) String() string {
+	return d.Op + " " + d.Command.String()
+}
+
+// Diff returns the commands to remove from expected, and the ones to add, to obtain actual, in the
+// order they appear. It returns nil if both lists hold the same commands in the same order.
+func Diff(expected, actual []Command) []CommandDiff {
+	// lcs[i][j] is the length of the longest common subsequence of expected[i:] and actual[j:].
+	lcs := make([][]int, len(expected)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(actual)+1)
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			switch {
+			case reflect.DeepEqual(expected[i], actual[j]):
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diffs []CommandDiff
+	i, j := 0, 0
+	for i < len(expected) || j < len(actual) {
+		switch {
+		case i < len(expected) && j < len(actual) && reflect.DeepEqual(expected[i], actual[j]):
+			i++
+			j++
+		case j == len(actual) || (i < len(expected) && lcs[i+1][j] >= lcs[i][j+1]):
+			diffs = append(diffs, CommandDiff{Op: DiffMissing, Index: i, Command: expected[i]})
+			i++
+		default:
+			diffs = append(diffs, CommandDiff{Op: DiffUnexpected, Index: j, Command: actual[j]})
+			j++
+		}
+	}
+
+	return diffs
+}
@@ -0,0 +1,61 @@
+package actiontest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_Commands(t *testing.T) {
+	api := NewAPI()
+	api.User.SetAppMetadata("logins", 3)
+	api.IDToken.SetCustomClaim("https://example.com/roles", []string{"admin"})
+	api.Redirect.SendUserTo("https://example.com/consent", map[string]string{"step": "1"})
+	api.Access.Deny("blocked")
+
+	commands, err := api.Commands()
+	require.NoError(t, err)
+	assert.Equal(t, []Command{
+		{Name: "user.setAppMetadata", Args: []interface{}{"logins", float64(3)}},
+		{Name: "idToken.setCustomClaim", Args: []interface{}{"https://example.com/roles", []interface{}{"admin"}}},
+		{Name: "redirect.sendUserTo", Args: []interface{}{"https://example.com/consent", map[string]interface{}{"query": map[string]interface{}{"step": "1"}}}},
+		{Name: "access.deny", Args: []interface{}{"blocked"}},
+	}, commands)
+	assert.Equal(t, `api.user.setAppMetadata("logins", 3)`, commands[0].String())
+
+	t.Run("Should fail on arguments that cannot be encoded", func(t *testing.T) {
+		api := NewAPI()
+		api.User.SetAppMetadata("callback", func() {})
+
+		_, err := api.Commands()
+		assert.ErrorContains(t, err, "failed to encode the recorded commands")
+	})
+}
+
+func TestDiff(t *testing.T) {
+	expected := NewAPI()
+	expected.User.SetAppMetadata("plan", "pro")
+	expected.IDToken.SetCustomClaim("plan", "pro")
+	expected.Multifactor.Enable("any")
+
+	actual := NewAPI()
+	actual.User.SetAppMetadata("plan", "pro")
+	actual.AccessToken.AddScope("read:plans")
+	actual.Multifactor.Enable("any")
+
+	expectedCommands, err := expected.Commands()
+	require.NoError(t, err)
+	actualCommands, err := actual.Commands()
+	require.NoError(t, err)
+
+	diffs := Diff(expectedCommands, actualCommands)
+
+	assert.Equal(t, []CommandDiff{
+		{Op: DiffMissing, Index: 1, Command: Command{Name: "idToken.setCustomClaim", Args: []interface{}{"plan", "pro"}}},
+		{Op: DiffUnexpected, Index: 1, Command: Command{Name: "accessToken.addScope", Args: []interface{}{"read:plans"}}},
+	}, diffs)
+	assert.Equal(t, `- api.idToken.setCustomClaim("plan", "pro")`, diffs[0].String())
+	assert.Nil(t, Diff(expectedCommands, expectedCommands))
+	assert.Len(t, Diff(nil, actualCommands), 3)
+}
@@ -0,0 +1,238 @@
+package actiontest
+
+import (
+	"time"
+
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+// Event is the `event` object an Action receives when its trigger executes.
+type Event interface {
+	// Trigger returns the ID of the trigger the event is sent to, e.g. `post-login`.
+	Trigger() string
+}
+
+// User is the user an event relates to.
+type User struct {
+	UserID            string                 `json:"user_id"`
+	Email             string                 `json:"email,omitempty"`
+	EmailVerified     bool                   `json:"email_verified"`
+	Username          string                 `json:"username,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	GivenName         string                 `json:"given_name,omitempty"`
+	FamilyName        string                 `json:"family_name,omitempty"`
+	Nickname          string                 `json:"nickname,omitempty"`
+	Picture           string                 `json:"picture,omitempty"`
+	PhoneNumber       string                 `json:"phone_number,omitempty"`
+	PhoneVerified     bool                   `json:"phone_verified"`
+	CreatedAt         *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time             `json:"updated_at,omitempty"`
+	LastPasswordReset *time.Time             `json:"last_password_reset,omitempty"`
+	AppMetadata       map[string]interface{} `json:"app_metadata"`
+	UserMetadata      map[string]interface{} `json:"user_metadata"`
+	Identities        []UserIdentity         `json:"identities"`
+	Multifactor       []string               `json:"multifactor,omitempty"`
+}
+
+// UserIdentity is an identity linked to the User of an event.
+type UserIdentity struct {
+	Connection  string                 `json:"connection"`
+	Provider    string                 `json:"provider"`
+	UserID      string                 `json:"user_id"`
+	IsSocial    bool                   `json:"isSocial"`
+	ProfileData map[string]interface{} `json:"profileData,omitempty"`
+}
+
+// Client is the application an event relates to.
+type Client struct {
+	ClientID string                 `json:"client_id"`
+	Name     string                 `json:"name"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// Organization is the organization a user logs in to.
+type Organization struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	DisplayName string            `json:"display_name,omitempty"`
+	Metadata    map[string]string `json:"metadata"`
+}
+
+// Connection is the connection used to authenticate or register a user.
+type Connection struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Strategy string                 `json:"strategy"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Request is the HTTP request that caused the trigger to execute.
+type Request struct {
+	IP        string                 `json:"ip"`
+	Method    string                 `json:"method,omitempty"`
+	Hostname  string                 `json:"hostname,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	Language  string                 `json:"language,omitempty"`
+	Query     map[string]interface{} `json:"query,omitempty"`
+	Body      map[string]interface{} `json:"body,omitempty"`
+	Geoip     *Geoip                 `json:"geoip,omitempty"`
+}
+
+// Geoip is the location of the IP address of a Request.
+type Geoip struct {
+	CityName      string  `json:"cityName,omitempty"`
+	CountryCode   string  `json:"countryCode,omitempty"`
+	CountryName   string  `json:"countryName,omitempty"`
+	ContinentCode string  `json:"continentCode,omitempty"`
+	Latitude      float64 `json:"latitude,omitempty"`
+	Longitude     float64 `json:"longitude,omitempty"`
+	TimeZone      string  `json:"timeZone,omitempty"`
+}
+
+// Tenant is the tenant the trigger executes in.
+type Tenant struct {
+	ID string `json:"id"`
+}
+
+// Transaction holds the details of the authorization request of a login.
+type Transaction struct {
+	Protocol          string   `json:"protocol,omitempty"`
+	RequestedScopes   []string `json:"requested_scopes,omitempty"`
+	ResponseMode      string   `json:"response_mode,omitempty"`
+	ResponseType      []string `json:"response_type,omitempty"`
+	RedirectURI       string   `json:"redirect_uri,omitempty"`
+	Locale            string   `json:"locale,omitempty"`
+	UILocales         []string `json:"ui_locales,omitempty"`
+	AcrValues         []string `json:"acr_values,omitempty"`
+	LoginHint         string   `json:"login_hint,omitempty"`
+	State             string   `json:"state,omitempty"`
+	RequestedAudience string   `json:"requested_audience,omitempty"`
+}
+
+// AuthenticationMethod is a method a user authenticated with during a login.
+type AuthenticationMethod struct {
+	Name      string     `json:"name"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// Authentication holds the methods a user authenticated with during a login.
+type Authentication struct {
+	Methods []AuthenticationMethod `json:"methods"`
+}
+
+// Authorization holds the roles of the user logging in.
+type Authorization struct {
+	Roles []string `json:"roles"`
+}
+
+// Stats holds login statistics of the user.
+type Stats struct {
+	LoginsCount int64 `json:"logins_count"`
+}
+
+// ResourceServer is the API an access token is requested for.
+type ResourceServer struct {
+	Identifier string `json:"identifier"`
+}
+
+// AccessToken holds the claims of the access token being issued.
+type AccessToken struct {
+	Scope        []string               `json:"scope,omitempty"`
+	CustomClaims map[string]interface{} `json:"customClaims,omitempty"`
+}
+
+// MessageOptions holds the message a send-phone-message Action must deliver.
+type MessageOptions struct {
+	// The action the message is sent for, either `enrollment` or `second-factor-authentication`.
+	Action string `json:"action"`
+	// The one-time code included in the message.
+	Code string `json:"code"`
+	// The way the message is delivered, either `sms` or `voice`.
+	MessageType string `json:"message_type"`
+	// The phone number the message is sent to.
+	Recipient string `json:"recipient"`
+	// The text of the message.
+	Text string `json:"text"`
+}
+
+// PostLoginEvent is the event of the `post-login` trigger.
+type PostLoginEvent struct {
+	User           *User             `json:"user"`
+	Client         *Client           `json:"client"`
+	Organization   *Organization     `json:"organization,omitempty"`
+	Connection     *Connection       `json:"connection"`
+	Request        *Request          `json:"request"`
+	Tenant         *Tenant           `json:"tenant"`
+	Transaction    *Transaction      `json:"transaction,omitempty"`
+	Authentication *Authentication   `json:"authentication,omitempty"`
+	Authorization  *Authorization    `json:"authorization,omitempty"`
+	ResourceServer *ResourceServer   `json:"resource_server,omitempty"`
+	Stats          *Stats            `json:"stats,omitempty"`
+	Secrets        map[string]string `json:"secrets,omitempty"`
+}
+
+// Trigger implements Event.
+func (*PostLoginEvent) Trigger() string {
+	return management.ActionTriggerPostLogin
+}
+
+// CredentialsExchangeEvent is the event of the `credentials-exchange` trigger.
+type CredentialsExchangeEvent struct {
+	Client         *Client           `json:"client"`
+	Request        *Request          `json:"request"`
+	Tenant         *Tenant           `json:"tenant"`
+	ResourceServer *ResourceServer   `json:"resource_server"`
+	AccessToken    *AccessToken      `json:"accessToken,omitempty"`
+	Transaction    *Transaction      `json:"transaction,omitempty"`
+	Secrets        map[string]string `json:"secrets,omitempty"`
+}
+
+// Trigger implements Event.
+func (*CredentialsExchangeEvent) Trigger() string {
+	return management.ActionTriggerCredentialsExchange
+}
+
+// PreUserRegistrationEvent is the event of the `pre-user-registration` trigger.
+type PreUserRegistrationEvent struct {
+	User        *User             `json:"user"`
+	Client      *Client           `json:"client,omitempty"`
+	Connection  *Connection       `json:"connection"`
+	Request     *Request          `json:"request"`
+	Tenant      *Tenant           `json:"tenant"`
+	Transaction *Transaction      `json:"transaction,omitempty"`
+	Secrets     map[string]string `json:"secrets,omitempty"`
+}
+
+// Trigger implements Event.
+func (*PreUserRegistrationEvent) Trigger() string {
+	return management.ActionTriggerPreUserRegistration
+}
+
+// PostChangePasswordEvent is the event of the `post-change-password` trigger.
+type PostChangePasswordEvent struct {
+	User       *User             `json:"user"`
+	Connection *Connection       `json:"connection"`
+	Request    *Request          `json:"request"`
+	Tenant     *Tenant           `json:"tenant"`
+	Secrets    map[string]string `json:"secrets,omitempty"`
+}
+
+// Trigger implements Event.
+func (*PostChangePasswordEvent) Trigger() string {
+	return management.ActionTriggerPostChangePassword
+}
+
+// SendPhoneMessageEvent is the event of the `send-phone-message` trigger.
+type SendPhoneMessageEvent struct {
+	User           *User             `json:"user"`
+	Client         *Client           `json:"client,omitempty"`
+	Request        *Request          `json:"request"`
+	Tenant         *Tenant           `json:"tenant"`
+	MessageOptions *MessageOptions   `json:"message_options"`
+	Secrets        map[string]string `json:"secrets,omitempty"`
+}
+
+// Trigger implements Event.
+func (*SendPhoneMessageEvent) Trigger() string {
+	return management.ActionTriggerSendPhoneMessage
+}
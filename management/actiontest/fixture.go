@@ -0,0 +1,272 @@
+package actiontest
+
+import (
+	"time"
+
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+// Fixture generates realistic events from Management API resources, so that Actions can be
+// tested against the users, applications and organizations they run for.
+//
+// Every event generated by a Fixture is a new value that can be modified freely.
+type Fixture struct {
+	// The user the events relate to. Required for every trigger but `credentials-exchange`.
+	User *management.User
+	// The application the events relate to.
+	Client *management.Client
+	// The organization the user logs in to, if any.
+	Organization *management.Organization
+	// The ID of the tenant. Defaults to `dev-tenant`.
+	TenantID string
+	// The request that caused the trigger to execute. Defaults to DefaultRequest.
+	Request *Request
+	// The time of the authentication. Defaults to the current time.
+	Now time.Time
+}
+
+// DefaultRequest returns the request used by a Fixture when none is set.
+func DefaultRequest() *Request {
+	return &Request{
+		IP:        "203.0.113.42",
+		Method:    "GET",
+		Hostname:  "dev-tenant.eu.auth0.com",
+		UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36",
+		Language:  "en",
+		Geoip: &Geoip{
+			CityName:      "Dublin",
+			CountryCode:   "IE",
+			CountryName:   "Ireland",
+			ContinentCode: "EU",
+			Latitude:      53.3331,
+			Longitude:     -6.2489,
+			TimeZone:      "Europe/Dublin",
+		},
+	}
+}
+
+// PostLogin returns a `post-login` event for the user logging in to the client, and to the
+// organization if one is set.
+func (f *Fixture) PostLogin() *PostLoginEvent {
+	now := f.now()
+
+	e := &PostLoginEvent{
+		User:         f.user(),
+		Client:       f.client(),
+		Organization: f.organization(),
+		Connection:   f.connection(),
+		Request:      f.request(),
+		Tenant:       f.tenant(),
+		Transaction: &Transaction{
+			Protocol:        "oidc-basic-profile",
+			RequestedScopes: []string{"openid", "profile", "email"},
+			ResponseType:    []string{"code"},
+			Locale:          "en",
+		},
+		Authentication: &Authentication{
+			Methods: []AuthenticationMethod{{Name: "pwd", Timestamp: &now}},
+		},
+		Authorization: &Authorization{Roles: []string{}},
+		Stats:         &Stats{LoginsCount: f.User.GetLoginsCount()},
+	}
+	if e.Organization != nil {
+		e.Transaction.RequestedScopes = append(e.Transaction.RequestedScopes, "organization")
+	}
+
+	return e
+}
+
+// CredentialsExchange returns a `credentials-exchange` event for the client requesting an access
+// token for the audience.
+func (f *Fixture) CredentialsExchange(audience string) *CredentialsExchangeEvent {
+	return &CredentialsExchangeEvent{
+		Client:         f.client(),
+		Request:        f.request(),
+		Tenant:         f.tenant(),
+		ResourceServer: &ResourceServer{Identifier: audience},
+		AccessToken:    &AccessToken{Scope: []string{}, CustomClaims: map[string]interface{}{}},
+		Transaction: &Transaction{
+			RequestedAudience: audience,
+		},
+	}
+}
+
+// PreUserRegistration returns a `pre-user-registration` event for the user signing up.
+func (f *Fixture) PreUserRegistration() *PreUserRegistrationEvent {
+	e := &PreUserRegistrationEvent{
+		User:       f.user(),
+		Client:     f.client(),
+		Connection: f.connection(),
+		Request:    f.request(),
+		Tenant:     f.tenant(),
+		Transaction: &Transaction{
+			Locale: "en",
+		},
+	}
+
+	// The user does not exist yet when registering, so it has no ID nor identities.
+	e.User.UserID = ""
+	e.User.Identities = nil
+	e.User.CreatedAt = nil
+	e.User.UpdatedAt = nil
+
+	return e
+}
+
+// PostChangePassword returns a `post-change-password` event for the user changing their password.
+func (f *Fixture) PostChangePassword() *PostChangePasswordEvent {
+	return &PostChangePasswordEvent{
+		User:       f.user(),
+		Connection: f.connection(),
+		Request:    f.request(),
+		Tenant:     f.tenant(),
+	}
+}
+
+// SendPhoneMessage returns a `send-phone-message` event for the code sent to the phone number of the
+// user as a second factor.
+func (f *Fixture) SendPhoneMessage(code string) *SendPhoneMessageEvent {
+	return &SendPhoneMessageEvent{
+		User:    f.user(),
+		Client:  f.client(),
+		Request: f.request(),
+		Tenant:  f.tenant(),
+		MessageOptions: &MessageOptions{
+			Action:      "second-factor-authentication",
+			Code:        code,
+			MessageType: "sms",
+			Recipient:   f.User.GetPhoneNumber(),
+			Text:        "Your verification code is: " + code,
+		},
+	}
+}
+
+func (f *Fixture) now() time.Time {
+	if f.Now.IsZero() {
+		return time.Now().UTC()
+	}
+	return f.Now
+}
+
+func (f *Fixture) user() *User {
+	u := f.User
+	if u == nil {
+		u = &management.User{}
+	}
+
+	user := &User{
+		UserID:            u.GetID(),
+		Email:             u.GetEmail(),
+		EmailVerified:     u.GetEmailVerified(),
+		Username:          u.GetUsername(),
+		Name:              u.GetName(),
+		GivenName:         u.GetGivenName(),
+		FamilyName:        u.GetFamilyName(),
+		Nickname:          u.GetNickname(),
+		Picture:           u.GetPicture(),
+		PhoneNumber:       u.GetPhoneNumber(),
+		PhoneVerified:     u.GetPhoneVerified(),
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+		LastPasswordReset: u.LastPasswordReset,
+		AppMetadata:       copyMap(u.AppMetadata),
+		UserMetadata:      copyMap(u.UserMetadata),
+		Identities:        []UserIdentity{},
+	}
+	if u.Multifactor != nil {
+		user.Multifactor = append([]string{}, *u.Multifactor...)
+	}
+	for _, identity := range u.Identities {
+		user.Identities = append(user.Identities, UserIdentity{
+			Connection:  identity.GetConnection(),
+			Provider:    identity.GetProvider(),
+			UserID:      identity.GetUserID(),
+			IsSocial:    identity.GetIsSocial(),
+			ProfileData: copyMap(identity.ProfileData),
+		})
+	}
+
+	return user
+}
+
+func (f *Fixture) client() *Client {
+	if f.Client == nil {
+		return nil
+	}
+
+	return &Client{
+		ClientID: f.Client.GetClientID(),
+		Name:     f.Client.GetName(),
+		Metadata: copyMap(f.Client.ClientMetadata),
+	}
+}
+
+func (f *Fixture) organization() *Organization {
+	if f.Organization == nil {
+		return nil
+	}
+
+	metadata := map[string]string{}
+	if f.Organization.Metadata != nil {
+		for key, value := range *f.Organization.Metadata {
+			metadata[key] = value
+		}
+	}
+
+	return &Organization{
+		ID:          f.Organization.GetID(),
+		Name:        f.Organization.GetName(),
+		DisplayName: f.Organization.GetDisplayName(),
+		Metadata:    metadata,
+	}
+}
+
+func (f *Fixture) connection() *Connection {
+	c := &Connection{
+		Name:     "Username-Password-Authentication",
+		Strategy: "auth0",
+	}
+	if f.User == nil {
+		return c
+	}
+
+	if f.User.GetConnection() != "" {
+		c.Name = f.User.GetConnection()
+	}
+	for _, identity := range f.User.Identities {
+		if f.User.GetConnection() == "" || identity.GetConnection() == f.User.GetConnection() {
+			c.Name = identity.GetConnection()
+			c.Strategy = identity.GetProvider()
+			break
+		}
+	}
+
+	return c
+}
+
+func (f *Fixture) request() *Request {
+	if f.Request == nil {
+		return DefaultRequest()
+	}
+
+	r := *f.Request
+	return &r
+}
+
+func (f *Fixture) tenant() *Tenant {
+	if f.TenantID == "" {
+		return &Tenant{ID: "dev-tenant"}
+	}
+	return &Tenant{ID: f.TenantID}
+}
+
+func copyMap(m *map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{}
+	if m == nil {
+		return c
+	}
+	for key, value := range *m {
+		c[key] = value
+	}
+	return c
+}
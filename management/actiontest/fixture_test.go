@@ -0,0 +1,98 @@
+package actiontest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+func givenAFixture() *Fixture {
+	loginsCount := int64(3)
+
+	return &Fixture{
+		User: &management.User{
+			ID:            auth0.String("auth0|123"),
+			Email:         auth0.String("alice@example.com"),
+			EmailVerified: auth0.Bool(true),
+			PhoneNumber:   auth0.String("+15555550100"),
+			LoginsCount:   &loginsCount,
+			AppMetadata:   &map[string]interface{}{"plan": "pro"},
+			Identities: []*management.UserIdentity{
+				{
+					Connection: auth0.String("Username-Password-Authentication"),
+					Provider:   auth0.String("auth0"),
+					UserID:     auth0.String("123"),
+				},
+			},
+		},
+		Client: &management.Client{
+			ClientID:       auth0.String("client-id"),
+			Name:           auth0.String("My App"),
+			ClientMetadata: &map[string]interface{}{"tier": "gold"},
+		},
+		Organization: &management.Organization{
+			ID:       auth0.String("org_123"),
+			Name:     auth0.String("acme"),
+			Metadata: &map[string]string{"region": "eu"},
+		},
+		Now: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFixture_PostLogin(t *testing.T) {
+	fixture := givenAFixture()
+
+	e := fixture.PostLogin()
+
+	assert.Equal(t, "auth0|123", e.User.UserID)
+	assert.True(t, e.User.EmailVerified)
+	assert.Equal(t, map[string]interface{}{"plan": "pro"}, e.User.AppMetadata)
+	assert.Equal(t, []UserIdentity{{Connection: "Username-Password-Authentication", Provider: "auth0", UserID: "123", ProfileData: map[string]interface{}{}}}, e.User.Identities)
+	assert.Equal(t, &Client{ClientID: "client-id", Name: "My App", Metadata: map[string]interface{}{"tier": "gold"}}, e.Client)
+	assert.Equal(t, &Organization{ID: "org_123", Name: "acme", Metadata: map[string]string{"region": "eu"}}, e.Organization)
+	assert.Equal(t, &Connection{Name: "Username-Password-Authentication", Strategy: "auth0"}, e.Connection)
+	assert.Equal(t, int64(3), e.Stats.LoginsCount)
+	assert.Equal(t, fixture.Now, *e.Authentication.Methods[0].Timestamp)
+	assert.Contains(t, e.Transaction.RequestedScopes, "organization")
+	assert.Equal(t, "dev-tenant", e.Tenant.ID)
+
+	e.User.AppMetadata["plan"] = "free"
+	e.Request.IP = "198.51.100.1"
+	assert.Equal(t, "pro", (*fixture.User.AppMetadata)["plan"])
+	assert.Equal(t, DefaultRequest().IP, fixture.PostLogin().Request.IP)
+
+	payload, err := Payload(e)
+	require.NoError(t, err)
+	assert.NoError(t, Validate(e.Trigger(), payload))
+}
+
+func TestFixture_Events(t *testing.T) {
+	fixture := givenAFixture()
+
+	for _, e := range []Event{
+		fixture.CredentialsExchange("https://api.example.com"),
+		fixture.PreUserRegistration(),
+		fixture.PostChangePassword(),
+		fixture.SendPhoneMessage("123456"),
+	} {
+		t.Run(e.Trigger(), func(t *testing.T) {
+			payload, err := Payload(e)
+			require.NoError(t, err)
+			assert.NoError(t, Validate(e.Trigger(), payload))
+		})
+	}
+
+	registration := fixture.PreUserRegistration()
+	assert.Empty(t, registration.User.UserID)
+	assert.Empty(t, registration.User.Identities)
+	assert.Equal(t, "alice@example.com", registration.User.Email)
+
+	message := fixture.SendPhoneMessage("123456")
+	assert.Equal(t, "+15555550100", message.MessageOptions.Recipient)
+	assert.Equal(t, "Your verification code is: 123456", message.MessageOptions.Text)
+}
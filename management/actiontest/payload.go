@@ -0,0 +1,175 @@
+package actiontest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+// requiredFields lists, per trigger, the fields of the event an Action can rely on being set.
+var requiredFields = map[string][]string{
+	management.ActionTriggerPostLogin: {
+		"user.user_id", "client.client_id", "connection.name", "connection.strategy", "request.ip", "tenant.id",
+	},
+	management.ActionTriggerCredentialsExchange: {
+		"client.client_id", "resource_server.identifier", "request.ip", "tenant.id",
+	},
+	management.ActionTriggerPreUserRegistration: {
+		"user", "connection.name", "connection.strategy", "request.ip", "tenant.id",
+	},
+	management.ActionTriggerPostChangePassword: {
+		"user.user_id", "connection.name", "request.ip", "tenant.id",
+	},
+	management.ActionTriggerSendPhoneMessage: {
+		"message_options.action", "message_options.code", "message_options.message_type",
+		"message_options.recipient", "request.ip", "tenant.id",
+	},
+}
+
+// Payload returns the ActionTestPayload to send to ActionManager.Test for the event.
+func Payload(e Event) (management.ActionTestPayload, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the %s event: %w", e.Trigger(), err)
+	}
+
+	var payload management.ActionTestPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode the %s event: %w", e.Trigger(), err)
+	}
+
+	return payload, nil
+}
+
+// Validate checks that the payload is a valid event for the trigger: every field an Action can
+// rely on is set, and the fields that are set have the type the trigger sends. All the problems
+// found are returned joined in a single error.
+func Validate(trigger string, payload management.ActionTestPayload) error {
+	fields, ok := requiredFields[trigger]
+	if !ok {
+		return fmt.Errorf("unsupported trigger %q", trigger)
+	}
+
+	var errs []error
+	if err := decode(trigger, payload); err != nil {
+		errs = append(errs, err)
+	}
+	for _, field := range fields {
+		if isEmpty(lookup(payload, field)) {
+			errs = append(errs, fmt.Errorf("event.%s is required by the %s trigger", field, trigger))
+		}
+	}
+
+	if trigger == management.ActionTriggerSendPhoneMessage {
+		messageType := lookup(payload, "message_options.message_type")
+		if messageType != nil && messageType != "sms" && messageType != "voice" {
+			errs = append(errs, fmt.Errorf("event.message_options.message_type must be sms or voice, got %v", messageType))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Result is the outcome of a test execution of an Action.
+type Result struct {
+	// The commands the Action gave to the `api` object, in order.
+	Commands []Command `json:"commands"`
+	// The output of the Action, e.g. what it logged with `console.log`.
+	Logs string `json:"logs"`
+	// Execution statistics, e.g. its duration.
+	Stats map[string]interface{} `json:"stats"`
+}
+
+// Run validates the event and sends it to the Action using the test endpoint of the Management API,
+// returning the commands the Action recorded.
+//
+// See: https://auth0.com/docs/api/management/v2/#!/Actions/post_test_action
+func Run(ctx context.Context, api *management.Management, actionID string, e Event, opts ...management.RequestOption) (*Result, error) {
+	payload, err := Payload(e)
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(e.Trigger(), payload); err != nil {
+		return nil, err
+	}
+
+	// The response payload is decoded into the event payload.
+	if err := api.Action.Test(ctx, actionID, &payload, opts...); err != nil {
+		return nil, err
+	}
+
+	return newResult(payload)
+}
+
+func newResult(payload management.ActionTestPayload) (*Result, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	if err := json.Unmarshal(b, result); err != nil {
+		return nil, fmt.Errorf("failed to decode the result of the test execution: %w", err)
+	}
+
+	return result, nil
+}
+
+// decode checks the types of the fields of the payload against the event of the trigger.
+func decode(trigger string, payload management.ActionTestPayload) error {
+	var e Event
+	switch trigger {
+	case management.ActionTriggerPostLogin:
+		e = &PostLoginEvent{}
+	case management.ActionTriggerCredentialsExchange:
+		e = &CredentialsExchangeEvent{}
+	case management.ActionTriggerPreUserRegistration:
+		e = &PreUserRegistrationEvent{}
+	case management.ActionTriggerPostChangePassword:
+		e = &PostChangePasswordEvent{}
+	case management.ActionTriggerSendPhoneMessage:
+		e = &SendPhoneMessageEvent{}
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, e); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return fmt.Errorf("event.%s must be of type %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func lookup(payload map[string]interface{}, path string) interface{} {
+	var value interface{} = payload
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
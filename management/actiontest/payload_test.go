@@ -0,0 +1,84 @@
+package actiontest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Should report missing fields", func(t *testing.T) {
+		err := Validate(management.ActionTriggerPostLogin, management.ActionTestPayload{
+			"user":    map[string]interface{}{"user_id": "auth0|123"},
+			"request": map[string]interface{}{"ip": ""},
+			"tenant":  map[string]interface{}{"id": "dev-tenant"},
+		})
+
+		assert.EqualError(t, err, "event.client.client_id is required by the post-login trigger\n"+
+			"event.connection.name is required by the post-login trigger\n"+
+			"event.connection.strategy is required by the post-login trigger\n"+
+			"event.request.ip is required by the post-login trigger")
+	})
+
+	t.Run("Should report fields of the wrong type", func(t *testing.T) {
+		payload, err := Payload(givenAFixture().SendPhoneMessage("123456"))
+		require.NoError(t, err)
+		payload["user"] = map[string]interface{}{"email_verified": "yes"}
+		payload["message_options"].(map[string]interface{})["message_type"] = "email"
+
+		err = Validate(management.ActionTriggerSendPhoneMessage, payload)
+
+		assert.EqualError(t, err, "event.user.email_verified must be of type bool, got string\n"+
+			"event.message_options.message_type must be sms or voice, got email")
+	})
+
+	t.Run("Should reject unsupported triggers", func(t *testing.T) {
+		assert.EqualError(t, Validate("iga-approval", management.ActionTestPayload{}), `unsupported trigger "iga-approval"`)
+	})
+}
+
+func TestRun(t *testing.T) {
+	var received map[string]interface{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/actions/actions/act_123/test", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		_, err := w.Write([]byte(`{"payload":{"logs":"hello\n","stats":{"total_request_duration_ms":12},` +
+			`"commands":[{"name":"user.setAppMetadata","args":["plan","pro"]}]}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	api, err := management.New(s.URL, management.WithInsecure())
+	require.NoError(t, err)
+
+	e := givenAFixture().PostLogin()
+	result, err := Run(context.Background(), api, "act_123", e)
+	require.NoError(t, err)
+
+	assert.Equal(t, "auth0|123", received["payload"].(map[string]interface{})["user"].(map[string]interface{})["user_id"])
+	assert.Equal(t, "hello\n", result.Logs)
+	assert.Equal(t, float64(12), result.Stats["total_request_duration_ms"])
+
+	expected := NewAPI()
+	expected.User.SetAppMetadata("plan", "pro")
+	expectedCommands, err := expected.Commands()
+	require.NoError(t, err)
+	assert.Nil(t, Diff(expectedCommands, result.Commands))
+
+	t.Run("Should not send invalid events", func(t *testing.T) {
+		e.Tenant = nil
+
+		_, err := Run(context.Background(), api, "act_123", e)
+
+		assert.EqualError(t, err, "event.tenant.id is required by the post-login trigger")
+	})
+}
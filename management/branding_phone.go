@@ -0,0 +1,236 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// BrandingPhoneProvider is the provider used to send phone notifications, such as SMS or voice
+// messages for passwordless and multi-factor authentication.
+//
+// See: https://auth0.com/docs/customize/phone-messages/configure-phone-messaging-providers
+type BrandingPhoneProvider struct {
+	// The ID of the phone provider.
+	ID *string `json:"id,omitempty"`
+
+	// The tenant the phone provider belongs to.
+	Tenant *string `json:"tenant,omitempty"`
+
+	// The name of the phone provider. Possible values: `twilio` or `custom`.
+	Name *string `json:"name,omitempty"`
+
+	// The channel of the phone provider, always `phone`.
+	Channel *string `json:"channel,omitempty"`
+
+	// Whether the phone provider is disabled.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// The configuration of the phone provider.
+	Configuration *BrandingPhoneProviderConfiguration `json:"configuration,omitempty"`
+
+	// The credentials of the phone provider. They are never returned by the API.
+	Credentials *BrandingPhoneProviderCredentials `json:"credentials,omitempty"`
+
+	// The date and time the phone provider was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the phone provider was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// BrandingPhoneProviderConfiguration holds the configuration of a BrandingPhoneProvider.
+type BrandingPhoneProviderConfiguration struct {
+	// The Twilio account SID.
+	SID *string `json:"sid,omitempty"`
+
+	// The phone number or alphanumeric sender ID messages are sent from.
+	DefaultFrom *string `json:"default_from,omitempty"`
+
+	// The Twilio Messaging Service SID, used instead of DefaultFrom.
+	MSSID *string `json:"mssid,omitempty"`
+
+	// The delivery methods supported by the provider. Possible values: `text` and `voice`.
+	DeliveryMethods *[]string `json:"delivery_methods,omitempty"`
+}
+
+// BrandingPhoneProviderCredentials holds the credentials of a BrandingPhoneProvider.
+type BrandingPhoneProviderCredentials struct {
+	// The Twilio auth token.
+	AuthToken *string `json:"auth_token,omitempty"`
+}
+
+// BrandingPhoneProviderList is a list of BrandingPhoneProviders.
+type BrandingPhoneProviderList struct {
+	Providers []*BrandingPhoneProvider `json:"providers"`
+}
+
+// BrandingPhoneTestNotification is a test notification sent using a phone provider or template.
+type BrandingPhoneTestNotification struct {
+	// The phone number to send the test notification to.
+	To *string `json:"to,omitempty"`
+
+	// The delivery method of the test notification. Possible values: `text` or `voice`.
+	DeliveryMethod *string `json:"delivery_method,omitempty"`
+
+	// The status code returned by the provider, if any. Set once the notification was sent.
+	Code *int `json:"code,omitempty"`
+
+	// A message describing the result of sending the notification. Set once the notification was sent.
+	Message *string `json:"message,omitempty"`
+}
+
+// BrandingPhoneProviderManager manages Auth0 BrandingPhoneProvider resources.
+type BrandingPhoneProviderManager manager
+
+// List the phone providers.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/get-branding-phone-providers
+func (m *BrandingPhoneProviderManager) List(ctx context.Context, opts ...RequestOption) (l *BrandingPhoneProviderList, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("branding", "phone", "providers"), &l, opts...)
+	return
+}
+
+// Create a phone provider.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/create-phone-provider
+func (m *BrandingPhoneProviderManager) Create(ctx context.Context, p *BrandingPhoneProvider, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("branding", "phone", "providers"), p, opts...)
+}
+
+// Read a phone provider.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/get-phone-provider
+func (m *BrandingPhoneProviderManager) Read(ctx context.Context, id string, opts ...RequestOption) (p *BrandingPhoneProvider, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("branding", "phone", "providers", id), &p, opts...)
+	return
+}
+
+// Update a phone provider.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/update-phone-provider
+func (m *BrandingPhoneProviderManager) Update(ctx context.Context, id string, p *BrandingPhoneProvider, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPatch, m.management.URI("branding", "phone", "providers", id), p, opts...)
+}
+
+// Delete a phone provider.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/delete-phone-provider
+func (m *BrandingPhoneProviderManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodDelete, m.management.URI("branding", "phone", "providers", id), nil, opts...)
+}
+
+// Test sends a test notification using a phone provider.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/try-phone-provider
+func (m *BrandingPhoneProviderManager) Test(ctx context.Context, id string, n *BrandingPhoneTestNotification, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("branding", "phone", "providers", id, "try"), n, opts...)
+}
+
+// BrandingPhoneNotificationTemplate is a template used to send phone notifications.
+//
+// See: https://auth0.com/docs/customize/phone-messages/customize-sms-and-voice-messages
+type BrandingPhoneNotificationTemplate struct {
+	// The ID of the template.
+	ID *string `json:"id,omitempty"`
+
+	// The tenant the template belongs to.
+	Tenant *string `json:"tenant,omitempty"`
+
+	// The channel of the template, always `phone`.
+	Channel *string `json:"channel,omitempty"`
+
+	// Whether the template can be customized.
+	Customizable *bool `json:"customizable,omitempty"`
+
+	// The type of the template. Possible values: `otp_verify`, `otp_enroll`, `change_password`,
+	// `blocked_account` or `password_breach`.
+	Type *string `json:"type,omitempty"`
+
+	// Whether the template is disabled.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// The content of the template.
+	Content *BrandingPhoneNotificationTemplateContent `json:"content,omitempty"`
+}
+
+// BrandingPhoneNotificationTemplateContent is the content of a BrandingPhoneNotificationTemplate.
+type BrandingPhoneNotificationTemplateContent struct {
+	// The syntax of the template body, always `liquid`.
+	Syntax *string `json:"syntax,omitempty"`
+
+	// The phone number or alphanumeric sender ID the notification is sent from, overriding the provider's.
+	From *string `json:"from,omitempty"`
+
+	// The bodies of the template for each delivery method.
+	Body *BrandingPhoneNotificationTemplateBody `json:"body,omitempty"`
+}
+
+// BrandingPhoneNotificationTemplateBody holds the bodies of a BrandingPhoneNotificationTemplate.
+type BrandingPhoneNotificationTemplateBody struct {
+	// The body of text messages.
+	Text *string `json:"text,omitempty"`
+
+	// The body of voice messages.
+	Voice *string `json:"voice,omitempty"`
+}
+
+// BrandingPhoneNotificationTemplateList is a list of BrandingPhoneNotificationTemplates.
+type BrandingPhoneNotificationTemplateList struct {
+	Templates []*BrandingPhoneNotificationTemplate `json:"templates"`
+}
+
+// BrandingPhoneNotificationTemplateManager manages Auth0 BrandingPhoneNotificationTemplate resources.
+type BrandingPhoneNotificationTemplateManager manager
+
+// List the phone notification templates.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/get-phone-templates
+func (m *BrandingPhoneNotificationTemplateManager) List(ctx context.Context, opts ...RequestOption) (l *BrandingPhoneNotificationTemplateList, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("branding", "phone", "templates"), &l, opts...)
+	return
+}
+
+// Create a phone notification template.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/create-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Create(ctx context.Context, t *BrandingPhoneNotificationTemplate, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("branding", "phone", "templates"), t, opts...)
+}
+
+// Read a phone notification template.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/get-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Read(ctx context.Context, id string, opts ...RequestOption) (t *BrandingPhoneNotificationTemplate, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("branding", "phone", "templates", id), &t, opts...)
+	return
+}
+
+// Update a phone notification template.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/update-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Update(ctx context.Context, id string, t *BrandingPhoneNotificationTemplate, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPatch, m.management.URI("branding", "phone", "templates", id), t, opts...)
+}
+
+// Delete a phone notification template.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/delete-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodDelete, m.management.URI("branding", "phone", "templates", id), nil, opts...)
+}
+
+// Reset a phone notification template to its default content.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/reset-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Reset(ctx context.Context, id string, opts ...RequestOption) (t *BrandingPhoneNotificationTemplate, err error) {
+	err = m.management.Request(ctx, http.MethodPatch, m.management.URI("branding", "phone", "templates", id, "reset"), &t, opts...)
+	return
+}
+
+// Test sends a test notification using a phone notification template.
+//
+// See: https://auth0.com/docs/api/management/v2/branding/try-phone-template
+func (m *BrandingPhoneNotificationTemplateManager) Test(ctx context.Context, id string, n *BrandingPhoneTestNotification, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("branding", "phone", "templates", id, "try"), n, opts...)
+}
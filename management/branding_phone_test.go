@@ -0,0 +1,242 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestBrandingPhoneProviderManager_Create(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	provider := givenABrandingPhoneProvider(t)
+	assert.NotEmpty(t, provider.GetID())
+	assert.Equal(t, "twilio", provider.GetName())
+}
+
+func TestBrandingPhoneProviderManager_Read(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneProvider(t)
+
+	actual, err := api.BrandingPhoneProvider.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetID(), actual.GetID())
+	assert.Equal(t, expected.GetConfiguration().GetSID(), actual.GetConfiguration().GetSID())
+}
+
+func TestBrandingPhoneProviderManager_Update(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneProvider(t)
+
+	err := api.BrandingPhoneProvider.Update(context.Background(), expected.GetID(), &BrandingPhoneProvider{
+		Disabled: auth0.Bool(true),
+	})
+	require.NoError(t, err)
+
+	actual, err := api.BrandingPhoneProvider.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.True(t, actual.GetDisabled())
+}
+
+func TestBrandingPhoneProviderManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneProvider(t)
+
+	err := api.BrandingPhoneProvider.Delete(context.Background(), expected.GetID())
+	require.NoError(t, err)
+
+	_, err = api.BrandingPhoneProvider.Read(context.Background(), expected.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
+
+func TestBrandingPhoneProviderManager_List(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneProvider(t)
+
+	providers, err := api.BrandingPhoneProvider.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, providers.Providers, 1)
+	assert.Equal(t, expected.GetID(), providers.Providers[0].GetID())
+}
+
+func TestBrandingPhoneProviderManager_Test(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	provider := givenABrandingPhoneProvider(t)
+
+	notification := &BrandingPhoneTestNotification{
+		To:             auth0.String("+15555555555"),
+		DeliveryMethod: auth0.String("text"),
+	}
+	err := api.BrandingPhoneProvider.Test(context.Background(), provider.GetID(), notification)
+	require.NoError(t, err)
+	assert.NotEmpty(t, notification.GetMessage())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Create(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	template := givenABrandingPhoneNotificationTemplate(t)
+	assert.NotEmpty(t, template.GetID())
+	assert.Equal(t, "otp_verify", template.GetType())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Read(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneNotificationTemplate(t)
+
+	actual, err := api.BrandingPhoneNotificationTemplate.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetID(), actual.GetID())
+	assert.Equal(t, expected.GetContent().GetBody().GetText(), actual.GetContent().GetBody().GetText())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Update(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneNotificationTemplate(t)
+
+	err := api.BrandingPhoneNotificationTemplate.Update(context.Background(), expected.GetID(), &BrandingPhoneNotificationTemplate{
+		Content: &BrandingPhoneNotificationTemplateContent{
+			Body: &BrandingPhoneNotificationTemplateBody{
+				Text: auth0.String("Your verification code is {{ code }}."),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	actual, err := api.BrandingPhoneNotificationTemplate.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Your verification code is {{ code }}.", actual.GetContent().GetBody().GetText())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneNotificationTemplate(t)
+
+	err := api.BrandingPhoneNotificationTemplate.Delete(context.Background(), expected.GetID())
+	require.NoError(t, err)
+
+	_, err = api.BrandingPhoneNotificationTemplate.Read(context.Background(), expected.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_List(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneNotificationTemplate(t)
+
+	templates, err := api.BrandingPhoneNotificationTemplate.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, templates.Templates, 1)
+	assert.Equal(t, expected.GetID(), templates.Templates[0].GetID())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Reset(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenABrandingPhoneNotificationTemplate(t)
+
+	actual, err := api.BrandingPhoneNotificationTemplate.Reset(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetID(), actual.GetID())
+	assert.NotEqual(t, expected.GetContent().GetBody().GetText(), actual.GetContent().GetBody().GetText())
+}
+
+func TestBrandingPhoneNotificationTemplateManager_Test(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	template := givenABrandingPhoneNotificationTemplate(t)
+
+	notification := &BrandingPhoneTestNotification{
+		To:             auth0.String("+15555555555"),
+		DeliveryMethod: auth0.String("text"),
+	}
+	err := api.BrandingPhoneNotificationTemplate.Test(context.Background(), template.GetID(), notification)
+	require.NoError(t, err)
+	assert.NotEmpty(t, notification.GetMessage())
+}
+
+func givenABrandingPhoneProvider(t *testing.T) *BrandingPhoneProvider {
+	t.Helper()
+
+	provider := &BrandingPhoneProvider{
+		Name: auth0.String("twilio"),
+		Configuration: &BrandingPhoneProviderConfiguration{
+			SID:             auth0.String("ACxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+			DefaultFrom:     auth0.String("+15555550100"),
+			DeliveryMethods: &[]string{"text", "voice"},
+		},
+		Credentials: &BrandingPhoneProviderCredentials{
+			AuthToken: auth0.String("test-auth-token"),
+		},
+	}
+
+	err := api.BrandingPhoneProvider.Create(context.Background(), provider)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupBrandingPhoneProvider(t, provider.GetID())
+	})
+
+	return provider
+}
+
+func cleanupBrandingPhoneProvider(t *testing.T, id string) {
+	t.Helper()
+
+	err := api.BrandingPhoneProvider.Delete(context.Background(), id)
+	if err != nil {
+		if err.(Error).Status() != http.StatusNotFound {
+			t.Error(err)
+		}
+	}
+}
+
+func givenABrandingPhoneNotificationTemplate(t *testing.T) *BrandingPhoneNotificationTemplate {
+	t.Helper()
+
+	template := &BrandingPhoneNotificationTemplate{
+		Type: auth0.String("otp_verify"),
+		Content: &BrandingPhoneNotificationTemplateContent{
+			From: auth0.String("+15555550100"),
+			Body: &BrandingPhoneNotificationTemplateBody{
+				Text:  auth0.String("{{ code }} is your verification code for {{ friendly_name }}."),
+				Voice: auth0.String("Your verification code is {{ code }}."),
+			},
+		},
+	}
+
+	err := api.BrandingPhoneNotificationTemplate.Create(context.Background(), template)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupBrandingPhoneNotificationTemplate(t, template.GetID())
+	})
+
+	return template
+}
+
+func cleanupBrandingPhoneNotificationTemplate(t *testing.T, id string) {
+	t.Helper()
+
+	err := api.BrandingPhoneNotificationTemplate.Delete(context.Background(), id)
+	if err != nil {
+		if err.(Error).Status() != http.StatusNotFound {
+			t.Error(err)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package management
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 // ClientGrant is a method through which applications can gain Access Tokens.
 //
@@ -16,6 +19,14 @@ type ClientGrant struct {
 	Audience *string `json:"audience,omitempty"`
 
 	Scope []string `json:"scope"`
+
+	// Defines whether organizations can be used with client credentials exchanges for this grant.
+	// Possible values: `deny`, `allow` or `require`.
+	OrganizationUsage *string `json:"organization_usage,omitempty"`
+
+	// If enabled, any organization can be used with this grant. If disabled (default), the grant
+	// must be explicitly assigned to the desired organizations.
+	AllowAnyOrganization *bool `json:"allow_any_organization,omitempty"`
 }
 
 // ClientGrantList is a list of ClientGrants.
@@ -38,7 +49,9 @@ func (m *ClientGrantManager) Create(ctx context.Context, g *ClientGrant, opts ..
 //
 // The Auth0 Management API does not offer a method to retrieve a client grant
 // by id, we fake this by listing all client grants and matching by id on the
-// client side. For this reason this method should be used with caution.
+// client side. For this reason this method should be used with caution, and
+// ReadByClientAndAudience preferred when the client and audience are known as
+// it only requests the matching client grant.
 func (m *ClientGrantManager) Read(ctx context.Context, id string, opts ...RequestOption) (*ClientGrant, error) {
 	var page int
 	for {
@@ -87,3 +100,124 @@ func (m *ClientGrantManager) List(ctx context.Context, opts ...RequestOption) (g
 	err = m.management.Request(ctx, "GET", m.management.URI("client-grants"), &gs, applyListDefaults(opts))
 	return
 }
+
+// ListByClient lists the client grants of a client using the `client_id` filter.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Client_Grants/get_client_grants
+func (m *ClientGrantManager) ListByClient(ctx context.Context, clientID string, opts ...RequestOption) (*ClientGrantList, error) {
+	return m.List(ctx, append(append([]RequestOption{}, opts...), Parameter("client_id", clientID))...)
+}
+
+// ListByAudience lists the client grants of a resource server using the `audience` filter.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Client_Grants/get_client_grants
+func (m *ClientGrantManager) ListByAudience(ctx context.Context, audience string, opts ...RequestOption) (*ClientGrantList, error) {
+	return m.List(ctx, append(append([]RequestOption{}, opts...), Parameter("audience", audience))...)
+}
+
+// ReadByClientAndAudience retrieves the client grant of a client for a resource server using the
+// `client_id` and `audience` filters, without walking every page of client grants.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Client_Grants/get_client_grants
+func (m *ClientGrantManager) ReadByClientAndAudience(ctx context.Context, clientID, audience string, opts ...RequestOption) (*ClientGrant, error) {
+	l, err := m.List(ctx, append(append([]RequestOption{}, opts...), Parameter("client_id", clientID), Parameter("audience", audience))...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range l.ClientGrants {
+		if g.GetClientID() == clientID && g.GetAudience() == audience {
+			return g, nil
+		}
+	}
+
+	return nil, &managementError{
+		StatusCode: 404,
+		Err:        "Not Found",
+		Message:    "Client grant not found",
+	}
+}
+
+// EnsureGrantWithScopes makes sure a client is granted access to a resource server with the scopes to
+// add and without the scopes to remove. The client grant is created with the scopes to add if it does
+// not exist yet, otherwise it is only updated if its scopes need to change, so calling
+// EnsureGrantWithScopes repeatedly with the same arguments is safe.
+//
+// The options are sent with the lookup as well as with the create or update, so they should only be
+// per-request options such as Header. Query options such as Parameter and Page are dropped from the
+// create and update.
+func (m *ClientGrantManager) EnsureGrantWithScopes(ctx context.Context, clientID, audience string, add, remove []string, opts ...RequestOption) (*ClientGrant, error) {
+	g, err := m.ReadByClientAndAudience(ctx, clientID, audience, opts...)
+	if err != nil {
+		if mErr, ok := err.(Error); !ok || mErr.Status() != http.StatusNotFound {
+			return nil, err
+		}
+
+		g = &ClientGrant{
+			ClientID: &clientID,
+			Audience: &audience,
+			Scope:    mergeScopes(nil, add, remove),
+		}
+		err = m.Create(ctx, g, withoutQuery(opts))
+		return g, err
+	}
+
+	scope := mergeScopes(g.Scope, add, remove)
+	if equalScopes(g.Scope, scope) {
+		return g, nil
+	}
+
+	if err := m.Update(ctx, g.GetID(), &ClientGrant{Scope: scope}, withoutQuery(opts)); err != nil {
+		return nil, err
+	}
+
+	g.Scope = scope
+	return g, nil
+}
+
+// withoutQuery applies the options while keeping the query of the request, so that list options
+// meant for a lookup are not sent with a write.
+func withoutQuery(options []RequestOption) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		query := r.URL.RawQuery
+		for _, option := range options {
+			option.apply(r)
+		}
+		r.URL.RawQuery = query
+	})
+}
+
+// mergeScopes returns the scopes with the scopes to add appended and the scopes to remove filtered out,
+// preserving the original order.
+func mergeScopes(scopes, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, s := range remove {
+		removed[s] = true
+	}
+
+	seen := make(map[string]bool, len(scopes)+len(add))
+	merged := make([]string, 0, len(scopes)+len(add))
+	for _, s := range append(append([]string{}, scopes...), add...) {
+		if removed[s] || seen[s] {
+			continue
+		}
+		seen[s] = true
+		merged = append(merged, s)
+	}
+
+	return merged
+}
+
+func equalScopes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
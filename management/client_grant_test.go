@@ -2,10 +2,14 @@ package management
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
 )
 
 func TestClientGrantManager_Create(t *testing.T) {
@@ -88,6 +92,85 @@ func TestClientGrantManager_List(t *testing.T) {
 	assert.Equal(t, len(clientGrantList.ClientGrants), 1)
 }
 
+func TestClientGrantManager_ReadByClientAndAudience(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expectedClientGrant := givenAClientGrant(t)
+
+	actualClientGrant, err := api.ClientGrant.ReadByClientAndAudience(
+		context.Background(),
+		expectedClientGrant.GetClientID(),
+		expectedClientGrant.GetAudience(),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedClientGrant.GetID(), actualClientGrant.GetID())
+	assert.Equal(t, expectedClientGrant.Scope, actualClientGrant.Scope)
+}
+
+func TestClientGrantManager_EnsureGrantWithScopes(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expectedClientGrant := givenAClientGrant(t)
+
+	for i := 0; i < 2; i++ {
+		actualClientGrant, err := api.ClientGrant.EnsureGrantWithScopes(
+			context.Background(),
+			expectedClientGrant.GetClientID(),
+			expectedClientGrant.GetAudience(),
+			[]string{"update:resource"},
+			[]string{"create:resource"},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedClientGrant.GetID(), actualClientGrant.GetID())
+		assert.Equal(t, []string{"update:resource"}, actualClientGrant.Scope)
+	}
+}
+
+func TestClientGrantManager_EnsureGrantWithScopesOptions(t *testing.T) {
+	var requests []*http.Request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		if r.Method == http.MethodGet {
+			writeJSON(t, w, &ClientGrantList{ClientGrants: []*ClientGrant{{
+				ID:       auth0.String("cgr_1"),
+				ClientID: auth0.String("client"),
+				Audience: auth0.String("https://api"),
+				Scope:    []string{"read"},
+			}}})
+			return
+		}
+		writeJSON(t, w, &ClientGrant{})
+	}))
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	opts := make([]RequestOption, 0, 4)
+	opts = append(opts, Header("X-Test", "test"), Page(1))
+
+	_, err = m.ClientGrant.EnsureGrantWithScopes(context.Background(), "client", "https://api", []string{"write"}, nil, opts...)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, "client", requests[0].URL.Query().Get("client_id"))
+	assert.Equal(t, "1", requests[0].URL.Query().Get("page"))
+	assert.Equal(t, http.MethodPatch, requests[1].Method)
+	assert.Empty(t, requests[1].URL.RawQuery)
+	assert.Equal(t, "test", requests[1].Header.Get("X-Test"))
+
+	// The filters must not be appended to the options of the caller.
+	assert.Nil(t, opts[:cap(opts)][2])
+}
+
+func TestMergeScopes(t *testing.T) {
+	assert.Equal(t, []string{"read", "write"}, mergeScopes([]string{"read"}, []string{"write", "read"}, nil))
+	assert.Equal(t, []string{"write"}, mergeScopes([]string{"read", "write"}, nil, []string{"read"}))
+	assert.Equal(t, []string{}, mergeScopes(nil, nil, nil))
+}
+
 func givenAClientGrant(t *testing.T) (clientGrant *ClientGrant) {
 	t.Helper()
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/ConsultingMD/go-auth0/internal/tag"
 )
@@ -1212,6 +1213,92 @@ func (c *ConnectionOptionsGoogleApps) SetScopes(enable bool, scopes ...string) {
 // ConnectionManager manages Auth0 Connection resources.
 type ConnectionManager manager
 
+// SCIMConfiguration is the inbound SCIM provisioning configuration of an enterprise connection, such as
+// a connection using the ConnectionOptionsSAML, ConnectionOptionsOIDC or ConnectionOptionsOkta options.
+//
+// See: https://auth0.com/docs/authenticate/protocols/scim
+type SCIMConfiguration struct {
+	// The ID of the connection the configuration belongs to.
+	ConnectionID *string `json:"connection_id,omitempty"`
+
+	// The name of the connection the configuration belongs to.
+	ConnectionName *string `json:"connection_name,omitempty"`
+
+	// The strategy of the connection the configuration belongs to.
+	Strategy *string `json:"strategy,omitempty"`
+
+	// The name of the tenant the configuration belongs to.
+	TenantName *string `json:"tenant_name,omitempty"`
+
+	// The SCIM attribute used as the user ID, e.g. `externalId` or `userName`.
+	UserIDAttribute *string `json:"user_id_attribute,omitempty"`
+
+	// The mapping between Auth0 and SCIM attributes.
+	Mapping *[]SCIMConfigurationMapping `json:"mapping,omitempty"`
+
+	// The date and time the configuration was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the configuration was last updated.
+	UpdatedOn *time.Time `json:"updated_on,omitempty"`
+}
+
+// SCIMConfigurationMapping maps an Auth0 user attribute to a SCIM attribute.
+type SCIMConfigurationMapping struct {
+	// The Auth0 user attribute, e.g. `email` or `app_metadata.department`.
+	Auth0 *string `json:"auth0,omitempty"`
+
+	// The SCIM attribute, e.g. `emails[primary eq true].value`.
+	SCIM *string `json:"scim,omitempty"`
+}
+
+// MapAttribute maps an Auth0 user attribute to a SCIM attribute, replacing any existing mapping of the
+// Auth0 attribute.
+func (c *SCIMConfiguration) MapAttribute(auth0Attribute, scimAttribute string) {
+	var mapping []SCIMConfigurationMapping
+	if c.Mapping != nil {
+		mapping = *c.Mapping
+	}
+
+	for i := range mapping {
+		if mapping[i].GetAuth0() == auth0Attribute {
+			mapping[i].SCIM = &scimAttribute
+			c.Mapping = &mapping
+			return
+		}
+	}
+
+	mapping = append(mapping, SCIMConfigurationMapping{
+		Auth0: &auth0Attribute,
+		SCIM:  &scimAttribute,
+	})
+	c.Mapping = &mapping
+}
+
+// SCIMToken is a token used by an identity provider to call the SCIM endpoint of a connection.
+type SCIMToken struct {
+	// The ID of the token.
+	TokenID *string `json:"token_id,omitempty"`
+
+	// The value of the token. It is only returned when the token is created.
+	Token *string `json:"token,omitempty"`
+
+	// The scopes of the token, e.g. `get:users`, `post:users`, `put:users`, `patch:users` or `delete:users`.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// The lifetime of the token in seconds. Only used when creating a token, it does not expire if not set.
+	TokenLifeTime *int `json:"token_lifetime,omitempty"`
+
+	// The date and time the token was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the token expires.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+
+	// The date and time the token was last used.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
 // ConnectionList is a list of Connections.
 type ConnectionList struct {
 	List
@@ -1273,3 +1360,67 @@ func (m *ConnectionManager) ReadByName(ctx context.Context, name string, opts ..
 	}
 	return nil, &managementError{404, "Not Found", "Connection not found"}
 }
+
+// CreateSCIMConfiguration enables inbound SCIM provisioning for a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/post-scim-configuration
+func (m *ConnectionManager) CreateSCIMConfiguration(ctx context.Context, id string, c *SCIMConfiguration, opts ...RequestOption) (err error) {
+	if c == nil {
+		c = &SCIMConfiguration{}
+	}
+	return m.management.Request(ctx, "POST", m.management.URI("connections", id, "scim-configuration"), c, opts...)
+}
+
+// ReadSCIMConfiguration retrieves the SCIM configuration of a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/get-scim-configuration
+func (m *ConnectionManager) ReadSCIMConfiguration(ctx context.Context, id string, opts ...RequestOption) (c *SCIMConfiguration, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("connections", id, "scim-configuration"), &c, opts...)
+	return
+}
+
+// UpdateSCIMConfiguration updates the user ID attribute and attribute mapping of the SCIM configuration
+// of a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/patch-scim-configuration
+func (m *ConnectionManager) UpdateSCIMConfiguration(ctx context.Context, id string, c *SCIMConfiguration, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, "PATCH", m.management.URI("connections", id, "scim-configuration"), c, opts...)
+}
+
+// DeleteSCIMConfiguration disables inbound SCIM provisioning for a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/delete-scim-configuration
+func (m *ConnectionManager) DeleteSCIMConfiguration(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, "DELETE", m.management.URI("connections", id, "scim-configuration"), nil, opts...)
+}
+
+// ReadSCIMDefaultConfiguration retrieves the default attribute mapping of the SCIM configuration of a
+// connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/get-default-mapping
+func (m *ConnectionManager) ReadSCIMDefaultConfiguration(ctx context.Context, id string, opts ...RequestOption) (c *SCIMConfiguration, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("connections", id, "scim-configuration", "default-mapping"), &c, opts...)
+	return
+}
+
+// CreateSCIMToken creates a SCIM token for a connection. The value of the token is only returned once.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/post-scim-token
+func (m *ConnectionManager) CreateSCIMToken(ctx context.Context, id string, t *SCIMToken, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, "POST", m.management.URI("connections", id, "scim-configuration", "tokens"), t, opts...)
+}
+
+// ListSCIMTokens lists the SCIM tokens of a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/get-scim-tokens
+func (m *ConnectionManager) ListSCIMTokens(ctx context.Context, id string, opts ...RequestOption) (t []*SCIMToken, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("connections", id, "scim-configuration", "tokens"), &t, opts...)
+	return
+}
+
+// DeleteSCIMToken revokes a SCIM token of a connection.
+//
+// See: https://auth0.com/docs/api/management/v2/connections/delete-tokens-by-token-id
+func (m *ConnectionManager) DeleteSCIMToken(ctx context.Context, id, tokenID string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, "DELETE", m.management.URI("connections", id, "scim-configuration", "tokens", tokenID), nil, opts...)
+}
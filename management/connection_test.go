@@ -579,6 +579,119 @@ func TestConnectionManager_List(t *testing.T) {
 	assert.Contains(t, connectionList.Connections, needle)
 }
 
+func TestConnectionManager_CreateSCIMConfiguration(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	scimConfiguration := givenASCIMConfiguration(t, connection.GetID())
+
+	assert.Equal(t, connection.GetID(), scimConfiguration.GetConnectionID())
+	assert.Equal(t, "okta", scimConfiguration.GetStrategy())
+	assert.Equal(t, "externalId", scimConfiguration.GetUserIDAttribute())
+}
+
+func TestConnectionManager_ReadSCIMConfiguration(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	expected := givenASCIMConfiguration(t, connection.GetID())
+
+	actual, err := api.Connection.ReadSCIMConfiguration(context.Background(), connection.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetUserIDAttribute(), actual.GetUserIDAttribute())
+	assert.Equal(t, expected.GetMapping(), actual.GetMapping())
+}
+
+func TestConnectionManager_UpdateSCIMConfiguration(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	scimConfiguration := givenASCIMConfiguration(t, connection.GetID())
+
+	update := &SCIMConfiguration{
+		UserIDAttribute: auth0.String("userName"),
+		Mapping:         scimConfiguration.Mapping,
+	}
+	update.MapAttribute("email", "emails[type eq \"work\"].value")
+	update.MapAttribute("app_metadata.department", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User.department")
+
+	err := api.Connection.UpdateSCIMConfiguration(context.Background(), connection.GetID(), update)
+	require.NoError(t, err)
+
+	actual, err := api.Connection.ReadSCIMConfiguration(context.Background(), connection.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "userName", actual.GetUserIDAttribute())
+	assert.Equal(t, update.GetMapping(), actual.GetMapping())
+}
+
+func TestConnectionManager_DeleteSCIMConfiguration(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	givenASCIMConfiguration(t, connection.GetID())
+
+	err := api.Connection.DeleteSCIMConfiguration(context.Background(), connection.GetID())
+	require.NoError(t, err)
+
+	_, err = api.Connection.ReadSCIMConfiguration(context.Background(), connection.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
+
+func TestConnectionManager_ReadSCIMDefaultConfiguration(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	givenASCIMConfiguration(t, connection.GetID())
+
+	defaultConfiguration, err := api.Connection.ReadSCIMDefaultConfiguration(context.Background(), connection.GetID())
+	require.NoError(t, err)
+	assert.NotEmpty(t, defaultConfiguration.GetMapping())
+}
+
+func TestConnectionManager_SCIMTokens(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	connection := givenAOktaConnection(t)
+	givenASCIMConfiguration(t, connection.GetID())
+
+	token := &SCIMToken{
+		Scopes:        &[]string{"get:users", "post:users"},
+		TokenLifeTime: auth0.Int(3600),
+	}
+	err := api.Connection.CreateSCIMToken(context.Background(), connection.GetID(), token)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.GetTokenID())
+	assert.NotEmpty(t, token.GetToken())
+	assert.NotEmpty(t, token.GetValidUntil())
+
+	tokens, err := api.Connection.ListSCIMTokens(context.Background(), connection.GetID())
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.GetTokenID(), tokens[0].GetTokenID())
+	assert.Empty(t, tokens[0].GetToken())
+
+	err = api.Connection.DeleteSCIMToken(context.Background(), connection.GetID(), token.GetTokenID())
+	require.NoError(t, err)
+
+	tokens, err = api.Connection.ListSCIMTokens(context.Background(), connection.GetID())
+	require.NoError(t, err)
+	assert.Len(t, tokens, 0)
+}
+
+func TestSCIMConfiguration_MapAttribute(t *testing.T) {
+	scimConfiguration := &SCIMConfiguration{}
+
+	scimConfiguration.MapAttribute("email", "emails[primary eq true].value")
+	scimConfiguration.MapAttribute("family_name", "name.familyName")
+	scimConfiguration.MapAttribute("email", "userName")
+
+	assert.Equal(t, []SCIMConfigurationMapping{
+		{Auth0: auth0.String("email"), SCIM: auth0.String("userName")},
+		{Auth0: auth0.String("family_name"), SCIM: auth0.String("name.familyName")},
+	}, scimConfiguration.GetMapping())
+}
+
 func TestConnectionOptionsScopes(t *testing.T) {
 	t.Run("It can successfully set the scopes on the options of a OIDC connection", func(t *testing.T) {
 		options := &ConnectionOptionsOIDC{}
@@ -669,3 +782,42 @@ func givenAConnection(t *testing.T, testCase connectionTestCase) *Connection {
 
 	return &connection
 }
+
+func givenAOktaConnection(t *testing.T) *Connection {
+	t.Helper()
+
+	return givenAConnection(t, connectionTestCase{
+		connection: Connection{
+			Name:     auth0.String("Test-Okta-SCIM-Connection"),
+			Strategy: auth0.String("okta"),
+		},
+		options: &ConnectionOptionsOkta{
+			ClientID:     auth0.String("4ef8d976-71bd-4473-a7ce-087d3f0fafd8"),
+			ClientSecret: auth0.String("mySecret"),
+			Domain:       auth0.String("domain.okta.com"),
+		},
+	})
+}
+
+func givenASCIMConfiguration(t *testing.T, connectionID string) *SCIMConfiguration {
+	t.Helper()
+
+	scimConfiguration := &SCIMConfiguration{
+		UserIDAttribute: auth0.String("externalId"),
+		Mapping: &[]SCIMConfigurationMapping{
+			{Auth0: auth0.String("email"), SCIM: auth0.String("emails[primary eq true].value")},
+		},
+	}
+
+	err := api.Connection.CreateSCIMConfiguration(context.Background(), connectionID, scimConfiguration)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := api.Connection.DeleteSCIMConfiguration(context.Background(), connectionID)
+		if err != nil && err.(Error).Status() != http.StatusNotFound {
+			t.Error(err)
+		}
+	})
+
+	return scimConfiguration
+}
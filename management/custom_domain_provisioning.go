@@ -0,0 +1,200 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// CustomDomainStatusReady is the status of a custom domain that is verified and serving requests.
+	CustomDomainStatusReady = "ready"
+
+	defaultCustomDomainVerifyInitialInterval = 5 * time.Second
+	defaultCustomDomainVerifyMaxInterval     = time.Minute
+	defaultCustomDomainVerifyTimeout         = 30 * time.Minute
+)
+
+// CustomDomainDNSRecord is a DNS record that must exist for a CustomDomain to be verified.
+type CustomDomainDNSRecord struct {
+	// The type of the record, either `CNAME` or `TXT`.
+	Type string
+
+	// The fully qualified name of the record, e.g. `login.example.com`.
+	Name string
+
+	// The value of the record.
+	Value string
+}
+
+// DNSRecords returns the DNS records required to verify the custom domain, as advertised in its
+// verification methods.
+func (c *CustomDomain) DNSRecords() []CustomDomainDNSRecord {
+	if c.Verification == nil {
+		return nil
+	}
+
+	var records []CustomDomainDNSRecord
+	for _, method := range c.Verification.Methods {
+		recordType, _ := method["name"].(string)
+		value, _ := method["record"].(string)
+		if recordType == "" || value == "" {
+			continue
+		}
+
+		name, _ := method["domain"].(string)
+		if name == "" {
+			name = c.GetDomain()
+		}
+
+		records = append(records, CustomDomainDNSRecord{
+			Type:  strings.ToUpper(recordType),
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	return records
+}
+
+// CustomDomainDNSProvider creates the DNS records required to verify a CustomDomain.
+//
+// Implementations wrap the API of the DNS provider hosting the zone of the custom domain.
+type CustomDomainDNSProvider interface {
+	// UpsertRecord creates the record, or updates its value if a record of the same type and name exists.
+	UpsertRecord(ctx context.Context, record CustomDomainDNSRecord) error
+}
+
+// MemoryDNSProvider is an in-memory CustomDomainDNSProvider, useful to test provisioning workflows
+// without a real DNS provider.
+type MemoryDNSProvider struct {
+	mu      sync.Mutex
+	records map[string]CustomDomainDNSRecord
+}
+
+// NewMemoryDNSProvider returns an empty MemoryDNSProvider.
+func NewMemoryDNSProvider() *MemoryDNSProvider {
+	return &MemoryDNSProvider{records: map[string]CustomDomainDNSRecord{}}
+}
+
+// UpsertRecord stores the record, replacing any record of the same type and name.
+func (p *MemoryDNSProvider) UpsertRecord(_ context.Context, record CustomDomainDNSRecord) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.records[record.Type+" "+record.Name] = record
+	return nil
+}
+
+// Records returns the stored records sorted by name and type.
+func (p *MemoryDNSProvider) Records() []CustomDomainDNSRecord {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	records := make([]CustomDomainDNSRecord, 0, len(p.records))
+	for _, record := range p.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		return records[i].Type < records[j].Type
+	})
+
+	return records
+}
+
+// CustomDomainVerifyOptions configures how VerifyUntilReady polls the verification of a custom domain.
+type CustomDomainVerifyOptions struct {
+	// The time to wait before the second verification attempt, doubled after every attempt. Defaults to 5 seconds.
+	InitialInterval time.Duration
+
+	// The maximum time to wait between verification attempts. Defaults to 1 minute.
+	MaxInterval time.Duration
+
+	// The maximum time to wait for the custom domain to be ready. Defaults to 30 minutes.
+	Timeout time.Duration
+}
+
+// VerifyUntilReady verifies the custom domain until its status is `ready`, waiting between attempts
+// with an exponential backoff. An error is returned if the custom domain is not ready before the
+// timeout, in which case DNS propagation may still be in progress.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/post_verify
+func (m *CustomDomainManager) VerifyUntilReady(ctx context.Context, id string, verifyOptions CustomDomainVerifyOptions, opts ...RequestOption) (*CustomDomain, error) {
+	interval := verifyOptions.InitialInterval
+	if interval <= 0 {
+		interval = defaultCustomDomainVerifyInitialInterval
+	}
+
+	maxInterval := verifyOptions.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultCustomDomainVerifyMaxInterval
+	}
+
+	timeout := verifyOptions.Timeout
+	if timeout <= 0 {
+		timeout = defaultCustomDomainVerifyTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		c, err := m.Verify(ctx, id, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.GetStatus() == CustomDomainStatusReady {
+			return c, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return c, fmt.Errorf("custom domain %q is not ready, its status is %q: %w", c.GetDomain(), c.GetStatus(), ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// Provision turns a custom domain live: it creates the custom domain if it does not have an ID yet or
+// reads it otherwise, creates the DNS records required to verify it using the DNS provider, and then verifies it until it
+// is ready using VerifyUntilReady.
+func (m *CustomDomainManager) Provision(
+	ctx context.Context,
+	c *CustomDomain,
+	dnsProvider CustomDomainDNSProvider,
+	verifyOptions CustomDomainVerifyOptions,
+	opts ...RequestOption,
+) (*CustomDomain, error) {
+	if c.GetID() == "" {
+		if err := m.Create(ctx, c, opts...); err != nil {
+			return nil, err
+		}
+	} else {
+		// The verification methods of an existing custom domain are only known to Auth0.
+		existing, err := m.Read(ctx, c.GetID(), opts...)
+		if err != nil {
+			return nil, err
+		}
+		c = existing
+	}
+
+	for _, record := range c.DNSRecords() {
+		if err := dnsProvider.UpsertRecord(ctx, record); err != nil {
+			return nil, fmt.Errorf("failed to create the %s record %q: %w", record.Type, record.Name, err)
+		}
+	}
+
+	return m.VerifyUntilReady(ctx, c.GetID(), verifyOptions, opts...)
+}
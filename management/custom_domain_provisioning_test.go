@@ -0,0 +1,154 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestCustomDomain_DNSRecords(t *testing.T) {
+	customDomain := &CustomDomain{
+		Domain: auth0.String("login.example.com"),
+		Verification: &CustomDomainVerification{
+			Methods: []map[string]interface{}{
+				{"name": "cname", "record": "tenant-cd-123.edge.tenants.auth0.com"},
+				{"name": "txt", "record": "auth0-domain-verification=123", "domain": "_cf-custom-hostname.login.example.com"},
+				{"name": "txt"},
+			},
+		},
+	}
+
+	assert.Equal(t, []CustomDomainDNSRecord{
+		{Type: "CNAME", Name: "login.example.com", Value: "tenant-cd-123.edge.tenants.auth0.com"},
+		{Type: "TXT", Name: "_cf-custom-hostname.login.example.com", Value: "auth0-domain-verification=123"},
+	}, customDomain.DNSRecords())
+
+	assert.Empty(t, (&CustomDomain{}).DNSRecords())
+}
+
+func TestCustomDomainManager_Provision(t *testing.T) {
+	verifyOptions := CustomDomainVerifyOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+		Timeout:         time.Second,
+	}
+
+	t.Run("Should create the DNS records and verify until ready", func(t *testing.T) {
+		m, verifyAttempts := withCustomDomainServer(t, 3)
+		dnsProvider := NewMemoryDNSProvider()
+
+		customDomain, err := m.CustomDomain.Provision(context.Background(), &CustomDomain{
+			Domain: auth0.String("login.example.com"),
+			Type:   auth0.String("auth0_managed_certs"),
+		}, dnsProvider, verifyOptions)
+
+		require.NoError(t, err)
+		assert.Equal(t, CustomDomainStatusReady, customDomain.GetStatus())
+		assert.Equal(t, 3, *verifyAttempts)
+		assert.Equal(t, []CustomDomainDNSRecord{
+			{Type: "CNAME", Name: "login.example.com", Value: "tenant-cd-123.edge.tenants.auth0.com"},
+		}, dnsProvider.Records())
+	})
+
+	t.Run("Should time out if the custom domain never becomes ready", func(t *testing.T) {
+		m, _ := withCustomDomainServer(t, -1)
+
+		customDomain, err := m.CustomDomain.Provision(context.Background(), &CustomDomain{
+			Domain: auth0.String("login.example.com"),
+			Type:   auth0.String("auth0_managed_certs"),
+		}, NewMemoryDNSProvider(), CustomDomainVerifyOptions{
+			InitialInterval: time.Millisecond,
+			Timeout:         20 * time.Millisecond,
+		})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, `custom domain "login.example.com" is not ready, its status is "pending_verification"`)
+		assert.Equal(t, "pending_verification", customDomain.GetStatus())
+	})
+
+	t.Run("Should read the DNS records of an existing custom domain", func(t *testing.T) {
+		m, verifyAttempts := withCustomDomainServer(t, 1)
+		dnsProvider := NewMemoryDNSProvider()
+
+		customDomain, err := m.CustomDomain.Provision(context.Background(), &CustomDomain{
+			ID: auth0.String("cd_123"),
+		}, dnsProvider, verifyOptions)
+
+		require.NoError(t, err)
+		assert.Equal(t, CustomDomainStatusReady, customDomain.GetStatus())
+		assert.Equal(t, 1, *verifyAttempts)
+		assert.Equal(t, []CustomDomainDNSRecord{
+			{Type: "CNAME", Name: "login.example.com", Value: "tenant-cd-123.edge.tenants.auth0.com"},
+		}, dnsProvider.Records())
+	})
+
+	t.Run("Should return DNS provider errors", func(t *testing.T) {
+		m, verifyAttempts := withCustomDomainServer(t, 1)
+
+		_, err := m.CustomDomain.Provision(context.Background(), &CustomDomain{
+			ID: auth0.String("cd_123"),
+		}, failingDNSProvider{}, verifyOptions)
+
+		assert.EqualError(t, err, `failed to create the CNAME record "login.example.com": zone not found`)
+		assert.Equal(t, 0, *verifyAttempts)
+	})
+}
+
+type failingDNSProvider struct{}
+
+func (failingDNSProvider) UpsertRecord(context.Context, CustomDomainDNSRecord) error {
+	return errors.New("zone not found")
+}
+
+// withCustomDomainServer starts a server that creates custom domains and reports them as ready after
+// readyAfter verification attempts, or never if readyAfter is negative.
+func withCustomDomainServer(t *testing.T, readyAfter int) (*Management, *int) {
+	t.Helper()
+
+	var verifyAttempts int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		customDomain := &CustomDomain{
+			ID:     auth0.String("cd_123"),
+			Domain: auth0.String("login.example.com"),
+			Status: auth0.String("pending_verification"),
+			Verification: &CustomDomainVerification{
+				Methods: []map[string]interface{}{
+					{"name": "cname", "record": "tenant-cd-123.edge.tenants.auth0.com"},
+				},
+			},
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/custom-domains":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/custom-domains/cd_123":
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/custom-domains/cd_123/verify":
+			verifyAttempts++
+			if readyAfter >= 0 && verifyAttempts >= readyAfter {
+				customDomain.Status = auth0.String(CustomDomainStatusReady)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		err := json.NewEncoder(w).Encode(customDomain)
+		require.NoError(t, err)
+	})
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	return m, &verifyAttempts
+}
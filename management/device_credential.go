@@ -0,0 +1,76 @@
+package management
+
+import "context"
+
+const (
+	// DeviceCredentialTypePublicKey is the type of device credentials holding a device's public key.
+	DeviceCredentialTypePublicKey = "public_key"
+
+	// DeviceCredentialTypeRefreshToken is the type of device credentials holding a refresh token.
+	DeviceCredentialTypeRefreshToken = "refresh_token"
+
+	// DeviceCredentialTypeRotatingRefreshToken is the type of device credentials holding a rotating refresh token.
+	DeviceCredentialTypeRotatingRefreshToken = "rotating_refresh_token"
+)
+
+// DeviceCredential is a credential, such as a refresh token or a public key, bound to a user's device.
+//
+// See: https://auth0.com/docs/secure/tokens/refresh-tokens/manage-refresh-tokens
+type DeviceCredential struct {
+	// The ID of the device credential.
+	ID *string `json:"id,omitempty"`
+
+	// The user agent or descriptive name of the device.
+	DeviceName *string `json:"device_name,omitempty"`
+
+	// A unique identifier for the device.
+	DeviceID *string `json:"device_id,omitempty"`
+
+	// The type of the credential. Possible values: `public_key`, `refresh_token` or `rotating_refresh_token`.
+	Type *string `json:"type,omitempty"`
+
+	// The base64 encoded value of the credential. Only used when creating a device credential.
+	Value *string `json:"value,omitempty"`
+
+	// The ID of the user the credential belongs to.
+	UserID *string `json:"user_id,omitempty"`
+
+	// The ID of the client the credential was issued to.
+	ClientID *string `json:"client_id,omitempty"`
+}
+
+// DeviceCredentialList is a list of DeviceCredentials.
+type DeviceCredentialList struct {
+	List
+	DeviceCredentials []*DeviceCredential `json:"device_credentials"`
+}
+
+// DeviceCredentialManager manages Auth0 DeviceCredential resources.
+type DeviceCredentialManager manager
+
+// List device credentials.
+//
+// Results can be filtered using the `user_id`, `client_id` and `type` parameters,
+// e.g. `Parameter("user_id", "auth0|123")`.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Device_Credentials/get_device_credentials
+func (m *DeviceCredentialManager) List(ctx context.Context, opts ...RequestOption) (d *DeviceCredentialList, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("device-credentials"), &d, applyListDefaults(opts))
+	return
+}
+
+// Create a device credential holding a device's public key.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Device_Credentials/post_device_credentials
+func (m *DeviceCredentialManager) Create(ctx context.Context, d *DeviceCredential, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "POST", m.management.URI("device-credentials"), d, opts...)
+	return
+}
+
+// Delete a device credential, revoking it.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Device_Credentials/delete_device_credentials_by_id
+func (m *DeviceCredentialManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("device-credentials", id), nil, opts...)
+	return
+}
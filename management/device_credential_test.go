@@ -0,0 +1,89 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestDeviceCredentialManager_Create(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	deviceCredential := givenADeviceCredential(t)
+	assert.NotEmpty(t, deviceCredential.GetID())
+}
+
+func TestDeviceCredentialManager_List(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenADeviceCredential(t)
+
+	deviceCredentials, err := api.DeviceCredential.List(
+		context.Background(),
+		Parameter("user_id", expected.GetUserID()),
+		Parameter("client_id", expected.GetClientID()),
+		Parameter("type", DeviceCredentialTypePublicKey),
+	)
+	require.NoError(t, err)
+	require.Len(t, deviceCredentials.DeviceCredentials, 1)
+	assert.Equal(t, expected.GetID(), deviceCredentials.DeviceCredentials[0].GetID())
+	assert.Equal(t, expected.GetDeviceName(), deviceCredentials.DeviceCredentials[0].GetDeviceName())
+	assert.Equal(t, DeviceCredentialTypePublicKey, deviceCredentials.DeviceCredentials[0].GetType())
+}
+
+func TestDeviceCredentialManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenADeviceCredential(t)
+
+	err := api.DeviceCredential.Delete(context.Background(), expected.GetID())
+	require.NoError(t, err)
+
+	deviceCredentials, err := api.DeviceCredential.List(
+		context.Background(),
+		Parameter("user_id", expected.GetUserID()),
+	)
+	require.NoError(t, err)
+	assert.Len(t, deviceCredentials.DeviceCredentials, 0)
+}
+
+func givenADeviceCredential(t *testing.T) *DeviceCredential {
+	t.Helper()
+
+	user := givenAUser(t)
+	client := givenAClient(t)
+
+	deviceCredential := &DeviceCredential{
+		DeviceName: auth0.String("Test Device"),
+		DeviceID:   auth0.String("test-device-id"),
+		Type:       auth0.String(DeviceCredentialTypePublicKey),
+		Value:      auth0.String("dGVzdC1wdWJsaWMta2V5"),
+		UserID:     auth0.String(user.GetID()),
+		ClientID:   auth0.String(client.GetClientID()),
+	}
+
+	err := api.DeviceCredential.Create(context.Background(), deviceCredential)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupDeviceCredential(t, deviceCredential.GetID())
+	})
+
+	return deviceCredential
+}
+
+func cleanupDeviceCredential(t *testing.T, id string) {
+	t.Helper()
+
+	err := api.DeviceCredential.Delete(context.Background(), id)
+	if err != nil {
+		if err.(Error).Status() != http.StatusNotFound {
+			t.Error(err)
+		}
+	}
+}
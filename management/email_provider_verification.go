@@ -0,0 +1,478 @@
+package management
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+// EmailProviderCheck is the outcome of a single check made while verifying an email provider.
+type EmailProviderCheck struct {
+	// The name of the check, such as "connect", "starttls", "auth" or "credentials.api_key".
+	Name string
+
+	// Whether the check passed.
+	Passed bool
+
+	// What was checked, or why the check failed.
+	Message string
+
+	// How long the check took, for the checks talking to the SMTP server.
+	Duration time.Duration
+}
+
+// EmailProviderVerification is the diagnostics report of an email provider.
+type EmailProviderVerification struct {
+	// The name of the email provider.
+	Provider string
+
+	// The checks made, in order. Checks of the SMTP server stop at the first failure.
+	Checks []*EmailProviderCheck
+
+	// The extensions advertised by the SMTP server, sorted.
+	Extensions []string
+
+	// The TLS version negotiated with the SMTP server, such as "TLS 1.3".
+	TLSVersion string
+
+	// The authentication mechanism used with the SMTP server, such as "PLAIN".
+	AuthMechanism string
+}
+
+// Passed returns whether all the checks passed.
+func (v *EmailProviderVerification) Passed() bool {
+	return v.Err() == nil
+}
+
+// Err returns an error joining the failed checks, or nil if all the checks passed.
+func (v *EmailProviderVerification) Err() error {
+	var errs []error
+	for _, c := range v.Checks {
+		if !c.Passed {
+			errs = append(errs, fmt.Errorf("%s: %s", c.Name, c.Message))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (v *EmailProviderVerification) pass(name, message string, start time.Time) {
+	v.Checks = append(v.Checks, &EmailProviderCheck{Name: name, Passed: true, Message: message, Duration: checkDuration(start)})
+}
+
+func (v *EmailProviderVerification) fail(name, message string, start time.Time) {
+	v.Checks = append(v.Checks, &EmailProviderCheck{Name: name, Message: message, Duration: checkDuration(start)})
+}
+
+// checkDuration returns how long a check started at start took, or 0 for checks that do not
+// contact the provider.
+func checkDuration(start time.Time) time.Duration {
+	if start.IsZero() {
+		return 0
+	}
+	return time.Since(start)
+}
+
+// EmailProviderVerifyOptions configures how an email provider is verified.
+type EmailProviderVerifyOptions struct {
+	// How long to wait for the SMTP server overall. Defaults to 10 seconds.
+	Timeout time.Duration
+
+	// The TLS configuration used with the SMTP server. The server name defaults to the SMTP host.
+	TLSConfig *tls.Config
+
+	// The host name sent with EHLO. Defaults to "localhost".
+	LocalName string
+
+	// Dials the SMTP server. Defaults to a net.Dialer.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// smtpImplicitTLSPort is the port of SMTP servers expecting TLS from the start instead of STARTTLS.
+const smtpImplicitTLSPort = 465
+
+// Verify checks the email provider without sending any email, to catch bad settings before
+// users stop receiving emails.
+//
+// For the `smtp` provider it connects to the SMTP host and port, negotiates TLS, with STARTTLS
+// or directly on port 465, and authenticates with the user and password. Credentials are never
+// sent unencrypted, so servers without TLS fail verification.
+//
+// For providers sending emails through an API it checks the shape of the credentials, without
+// calling the API.
+//
+// An error is returned if the provider cannot be verified, otherwise the failed checks are
+// reported in the EmailProviderVerification.
+func (ep *EmailProvider) Verify(ctx context.Context, options *EmailProviderVerifyOptions) (*EmailProviderVerification, error) {
+	if options == nil {
+		options = &EmailProviderVerifyOptions{}
+	}
+
+	v := &EmailProviderVerification{Provider: ep.GetName()}
+	switch ep.GetName() {
+	case EmailProviderSMTP:
+		credentials, ok := ep.Credentials.(*EmailProviderCredentialsSMTP)
+		if !ok {
+			return nil, credentialsTypeError(ep)
+		}
+		verifySMTP(ctx, v, credentials, options)
+	case EmailProviderMandrill, EmailProviderSES, EmailProviderSendGrid, EmailProviderSparkPost,
+		EmailProviderMailgun, EmailProviderAzureCS, EmailProviderMS365:
+		if err := verifyCredentials(v, ep); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cannot verify the %q email provider", ep.GetName())
+	}
+
+	return v, nil
+}
+
+func credentialsTypeError(ep *EmailProvider) error {
+	return fmt.Errorf("the %q email provider cannot use credentials of type %T", ep.GetName(), ep.Credentials)
+}
+
+func verifySMTP(ctx context.Context, v *EmailProviderVerification, c *EmailProviderCredentialsSMTP, options *EmailProviderVerifyOptions) {
+	host, port := c.GetSMTPHost(), c.GetSMTPPort()
+	var missing []string
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"smtp_host", host != ""},
+		{"smtp_port", c.SMTPPort != nil},
+		{"smtp_user", c.GetSMTPUser() != ""},
+		{"smtp_pass", c.GetSMTPPass() != ""},
+	} {
+		if !field.set {
+			missing = append(missing, field.name)
+		}
+	}
+	switch {
+	case len(missing) > 0:
+		v.fail("configuration", "missing "+strings.Join(missing, ", "), time.Time{})
+		return
+	case port <= 0 || port > 65535:
+		v.fail("configuration", fmt.Sprintf("invalid port %d", port), time.Time{})
+		return
+	}
+	v.pass("configuration", "host, port, user and password are set", time.Time{})
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dial := options.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	start := time.Now()
+	conn, err := dial(ctx, "tcp", address)
+	if err != nil {
+		v.fail("connect", err.Error(), start)
+		return
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// Unblock the conversation with the server when the context is canceled.
+	done := make(chan struct{})
+	defer close(done)
+	go func(conn net.Conn) {
+		select {
+		case <-ctx.Done():
+			_ = conn.SetDeadline(time.Now())
+		case <-done:
+		}
+	}(conn)
+	v.pass("connect", "connected to "+address, start)
+
+	tlsConfig := &tls.Config{}
+	if options.TLSConfig != nil {
+		tlsConfig = options.TLSConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+
+	if port == smtpImplicitTLSPort {
+		start = time.Now()
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			v.fail("tls", err.Error(), start)
+			return
+		}
+		conn = tlsConn
+		v.TLSVersion = tlsVersionName(tlsConn.ConnectionState().Version)
+		v.pass("tls", "negotiated "+v.TLSVersion, start)
+	}
+
+	start = time.Now()
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		v.fail("greeting", err.Error(), start)
+		return
+	}
+	defer client.Close()
+
+	localName := options.LocalName
+	if localName == "" {
+		localName = "localhost"
+	}
+	if err := client.Hello(localName); err != nil {
+		v.fail("greeting", err.Error(), start)
+		return
+	}
+	v.Extensions = smtpExtensions(client)
+	v.pass("greeting", "the server accepted EHLO", start)
+
+	if port != smtpImplicitTLSPort {
+		start = time.Now()
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			v.fail("starttls", "the server does not support STARTTLS, credentials would be sent unencrypted", start)
+			return
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			v.fail("starttls", err.Error(), start)
+			return
+		}
+		state, _ := client.TLSConnectionState()
+		v.TLSVersion = tlsVersionName(state.Version)
+		v.Extensions = smtpExtensions(client)
+		v.pass("starttls", "negotiated "+v.TLSVersion, start)
+	}
+
+	start = time.Now()
+	_, mechanisms := client.Extension("AUTH")
+	auth, mechanism := smtpAuth(strings.Fields(strings.ToUpper(mechanisms)), host, c.GetSMTPUser(), c.GetSMTPPass())
+	if auth == nil {
+		v.fail("auth", fmt.Sprintf("the server supports none of PLAIN, LOGIN or CRAM-MD5 (advertised %q)", mechanisms), start)
+		return
+	}
+	v.AuthMechanism = mechanism
+	if err := client.Auth(auth); err != nil {
+		v.fail("auth", err.Error(), start)
+		return
+	}
+	v.pass("auth", "authenticated as "+c.GetSMTPUser()+" with "+mechanism, start)
+
+	_ = client.Quit()
+}
+
+// smtpExtensions returns the extensions advertised by the server after EHLO, sorted.
+func smtpExtensions(client *smtp.Client) []string {
+	var extensions []string
+	for _, name := range []string{"8BITMIME", "AUTH", "CHUNKING", "DSN", "ENHANCEDSTATUSCODES", "PIPELINING", "SIZE", "SMTPUTF8", "STARTTLS"} {
+		if ok, _ := client.Extension(name); ok {
+			extensions = append(extensions, name)
+		}
+	}
+	sort.Strings(extensions)
+	return extensions
+}
+
+// smtpAuth picks the authentication mechanism to use among the ones the server supports.
+func smtpAuth(mechanisms []string, host, user, pass string) (smtp.Auth, string) {
+	supported := map[string]bool{}
+	for _, mechanism := range mechanisms {
+		supported[mechanism] = true
+	}
+	switch {
+	case supported["PLAIN"]:
+		return smtp.PlainAuth("", user, pass, host), "PLAIN"
+	case supported["LOGIN"]:
+		return &smtpLoginAuth{user: user, pass: pass}, "LOGIN"
+	case supported["CRAM-MD5"]:
+		return smtp.CRAMMD5Auth(user, pass), "CRAM-MD5"
+	}
+	return nil, ""
+}
+
+// smtpLoginAuth implements the LOGIN mechanism, which net/smtp does not provide.
+type smtpLoginAuth struct {
+	user, pass string
+}
+
+func (a *smtpLoginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *smtpLoginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch prompt := strings.ToLower(strings.TrimSpace(string(fromServer))); {
+	case strings.HasPrefix(prompt, "username"):
+		return []byte(a.user), nil
+	case strings.HasPrefix(prompt, "password"):
+		return []byte(a.pass), nil
+	default:
+		return nil, fmt.Errorf("unexpected LOGIN prompt %q", base64.StdEncoding.EncodeToString(fromServer))
+	}
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	}
+	return fmt.Sprintf("0x%04X", version)
+}
+
+var (
+	sesAccessKeyIDPattern     = regexp.MustCompile(`^(AKIA|ASIA)[A-Z0-9]{16}$`)
+	sesSecretAccessKeyPattern = regexp.MustCompile(`^[A-Za-z0-9/+=]{40}$`)
+	awsRegionPattern          = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+	sendGridAPIKeyPattern     = regexp.MustCompile(`^SG\.[\w-]+\.[\w-]+$`)
+	uuidPattern               = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// credentialCheck checks a field of the credentials of a provider sending emails through an API.
+type credentialCheck struct {
+	field string
+	value *string
+	// Whether the field may be omitted.
+	optional bool
+	// Returns why the value is invalid, or an empty string.
+	validate func(value string) string
+}
+
+// credentialMatches validates that a credential matches the pattern.
+func credentialMatches(pattern *regexp.Regexp, description string) func(string) string {
+	return func(value string) string {
+		if !pattern.MatchString(value) {
+			return "must be " + description
+		}
+		return ""
+	}
+}
+
+// credentialOneOf validates that a credential is one of the values.
+func credentialOneOf(values ...string) func(string) string {
+	return func(value string) string {
+		for _, v := range values {
+			if value == v {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %q", values)
+	}
+}
+
+func verifyCredentials(v *EmailProviderVerification, ep *EmailProvider) error {
+	if emailProviderOfCredentials(ep.Credentials) != ep.GetName() {
+		return credentialsTypeError(ep)
+	}
+
+	var checks []credentialCheck
+	switch credentials := ep.Credentials.(type) {
+	case *EmailProviderCredentialsMandrill:
+		checks = []credentialCheck{{field: "api_key", value: credentials.APIKey}}
+	case *EmailProviderCredentialsSES:
+		checks = []credentialCheck{
+			{field: "accessKeyId", value: credentials.AccessKeyID, validate: credentialMatches(sesAccessKeyIDPattern, "an AWS access key ID")},
+			{field: "secretAccessKey", value: credentials.SecretAccessKey, validate: credentialMatches(sesSecretAccessKeyPattern, "an AWS secret access key of 40 characters")},
+			{field: "region", value: credentials.Region, validate: credentialMatches(awsRegionPattern, "an AWS region such as us-east-1")},
+		}
+	case *EmailProviderCredentialsSendGrid:
+		checks = []credentialCheck{
+			{field: "api_key", value: credentials.APIKey, validate: credentialMatches(sendGridAPIKeyPattern, "a SendGrid API key starting with SG.")},
+		}
+	case *EmailProviderCredentialsSparkPost:
+		checks = []credentialCheck{
+			{field: "api_key", value: credentials.APIKey},
+			{field: "region", value: credentials.Region, optional: true, validate: credentialOneOf("eu")},
+		}
+	case *EmailProviderCredentialsMailgun:
+		checks = []credentialCheck{
+			{field: "api_key", value: credentials.APIKey},
+			{field: "domain", value: credentials.Domain, validate: func(value string) string {
+				if strings.Contains(value, "://") || strings.Contains(value, "/") {
+					return "must be a domain name, not a URL"
+				}
+				return ""
+			}},
+			{field: "region", value: credentials.Region, optional: true, validate: credentialOneOf("eu")},
+		}
+	case *EmailProviderCredentialsAzureCS:
+		checks = []credentialCheck{
+			{field: "connectionString", value: credentials.ConnectionString, validate: func(value string) string {
+				lower := strings.ToLower(value)
+				if !strings.Contains(lower, "endpoint=") || !strings.Contains(lower, "accesskey=") {
+					return "must contain an endpoint and an accesskey"
+				}
+				return ""
+			}},
+		}
+	case *EmailProviderCredentialsMS365:
+		checks = []credentialCheck{
+			{field: "tenantId", value: credentials.TenantID, validate: credentialMatches(uuidPattern, "a UUID")},
+			{field: "clientId", value: credentials.ClientID, validate: credentialMatches(uuidPattern, "a UUID")},
+			{field: "clientSecret", value: credentials.ClientSecret},
+		}
+	}
+
+	for _, check := range checks {
+		name := "credentials." + check.field
+		value := strings.TrimSpace(auth0.StringValue(check.value))
+		switch {
+		case value == "" && check.optional:
+			v.pass(name, "not set", time.Time{})
+		case value == "":
+			v.fail(name, "is required", time.Time{})
+		case check.validate != nil && check.validate(value) != "":
+			v.fail(name, check.validate(value), time.Time{})
+		default:
+			v.pass(name, "is valid", time.Time{})
+		}
+	}
+	return nil
+}
+
+// emailProviderOfCredentials returns the name of the email provider using the credentials.
+func emailProviderOfCredentials(credentials interface{}) string {
+	switch credentials.(type) {
+	case *EmailProviderCredentialsMandrill:
+		return EmailProviderMandrill
+	case *EmailProviderCredentialsSES:
+		return EmailProviderSES
+	case *EmailProviderCredentialsSendGrid:
+		return EmailProviderSendGrid
+	case *EmailProviderCredentialsSparkPost:
+		return EmailProviderSparkPost
+	case *EmailProviderCredentialsMailgun:
+		return EmailProviderMailgun
+	case *EmailProviderCredentialsSMTP:
+		return EmailProviderSMTP
+	case *EmailProviderCredentialsAzureCS:
+		return EmailProviderAzureCS
+	case *EmailProviderCredentialsMS365:
+		return EmailProviderMS365
+	}
+	return ""
+}
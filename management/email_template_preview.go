@@ -0,0 +1,231 @@
+package management
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/internal/liquid"
+)
+
+// EmailTemplateSyntaxLiquid is the syntax of email templates written in Liquid.
+const EmailTemplateSyntaxLiquid = "liquid"
+
+// EmailTemplateContext holds the objects available to email templates when they are rendered.
+//
+// Fields left nil are not defined in the template, so outputting them is reported as undefined.
+//
+// See: https://auth0.com/docs/customize/email/email-templates#common-variables
+type EmailTemplateContext struct {
+	// The user the email is sent to, available as `user`.
+	User *User
+
+	// The application the user interacted with, available as `application`.
+	Client *Client
+
+	// The organization the user belongs to, available as `organization`.
+	Organization *Organization
+
+	// The tenant settings, available as `friendly_name`, `support_email` and `support_url`.
+	Tenant *Tenant
+
+	// The name of the tenant, available as `tenant`.
+	TenantName *string
+
+	// The connection the user signed up with, available as `connection.name`.
+	ConnectionName *string
+
+	// The link of the email, such as the verification or the password reset link, available as `url`.
+	URL *string
+
+	// The one-time code of the email, such as the MFA code, available as `code`.
+	Code *string
+
+	// The language requested by the user, available as `request_language`.
+	RequestLanguage *string
+
+	// Additional variables, overriding the ones above.
+	Extra map[string]interface{}
+}
+
+// Variables returns the variables of the context as they are named in email templates.
+func (c *EmailTemplateContext) Variables() map[string]interface{} {
+	variables := map[string]interface{}{}
+	if c == nil {
+		return variables
+	}
+
+	if c.User != nil {
+		user := map[string]interface{}{}
+		setVariable(user, "email", c.User.Email)
+		setVariable(user, "email_verified", c.User.EmailVerified)
+		setVariable(user, "name", c.User.Name)
+		setVariable(user, "given_name", c.User.GivenName)
+		setVariable(user, "family_name", c.User.FamilyName)
+		setVariable(user, "nickname", c.User.Nickname)
+		setVariable(user, "picture", c.User.Picture)
+		setVariable(user, "app_metadata", c.User.AppMetadata)
+		setVariable(user, "user_metadata", c.User.UserMetadata)
+		variables["user"] = user
+	}
+
+	if c.Client != nil {
+		application := map[string]interface{}{}
+		setVariable(application, "name", c.Client.Name)
+		setVariable(application, "clientID", c.Client.ClientID)
+		setVariable(application, "clientMetadata", c.Client.ClientMetadata)
+		variables["application"] = application
+	}
+
+	if c.Organization != nil {
+		organization := map[string]interface{}{}
+		setVariable(organization, "id", c.Organization.ID)
+		setVariable(organization, "name", c.Organization.Name)
+		setVariable(organization, "display_name", c.Organization.DisplayName)
+		setVariable(organization, "metadata", c.Organization.Metadata)
+		if b := c.Organization.Branding; b != nil {
+			branding := map[string]interface{}{}
+			setVariable(branding, "logo_url", b.LogoURL)
+			setVariable(branding, "colors", b.Colors)
+			organization["branding"] = branding
+		}
+		variables["organization"] = organization
+	}
+
+	if c.Tenant != nil {
+		setVariable(variables, "friendly_name", c.Tenant.FriendlyName)
+		setVariable(variables, "support_email", c.Tenant.SupportEmail)
+		setVariable(variables, "support_url", c.Tenant.SupportURL)
+	}
+
+	setVariable(variables, "tenant", c.TenantName)
+	if c.ConnectionName != nil {
+		variables["connection"] = map[string]interface{}{"name": *c.ConnectionName}
+	}
+	setVariable(variables, "url", c.URL)
+	setVariable(variables, "code", c.Code)
+	setVariable(variables, "request_language", c.RequestLanguage)
+
+	for key, value := range c.Extra {
+		variables[key] = value
+	}
+
+	return variables
+}
+
+// setVariable sets the variable to the value the pointer refers to, unless it is nil.
+func setVariable(variables map[string]interface{}, name string, value interface{}) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && !v.IsNil() {
+		variables[name] = v.Elem().Interface()
+	}
+}
+
+// SampleEmailTemplateContext returns a context filled with sample values, to preview templates
+// without real users.
+func SampleEmailTemplateContext() *EmailTemplateContext {
+	return &EmailTemplateContext{
+		User: &User{
+			Email:         auth0.String("jane.doe@example.com"),
+			EmailVerified: auth0.Bool(false),
+			Name:          auth0.String("Jane Doe"),
+			GivenName:     auth0.String("Jane"),
+			FamilyName:    auth0.String("Doe"),
+			Nickname:      auth0.String("jane.doe"),
+			Picture:       auth0.String("https://example.com/jane.png"),
+			AppMetadata:   &map[string]interface{}{},
+			UserMetadata:  &map[string]interface{}{},
+		},
+		Client: &Client{
+			Name:           auth0.String("My App"),
+			ClientID:       auth0.String("sample-client-id"),
+			ClientMetadata: &map[string]interface{}{},
+		},
+		Organization: &Organization{
+			ID:          auth0.String("org_sample"),
+			Name:        auth0.String("acme"),
+			DisplayName: auth0.String("Acme"),
+			Metadata:    &map[string]string{},
+			Branding: &OrganizationBranding{
+				LogoURL: auth0.String("https://example.com/logo.png"),
+				Colors:  &map[string]string{"primary": "#0059d6", "page_background": "#000000"},
+			},
+		},
+		Tenant: &Tenant{
+			FriendlyName: auth0.String("My Company"),
+			SupportEmail: auth0.String("support@example.com"),
+			SupportURL:   auth0.String("https://example.com/support"),
+		},
+		TenantName:      auth0.String("my-tenant"),
+		ConnectionName:  auth0.String("Username-Password-Authentication"),
+		URL:             auth0.String("https://my-tenant.auth0.com/lo/verify_email?ticket=sample"),
+		Code:            auth0.String("123456"),
+		RequestLanguage: auth0.String("en"),
+	}
+}
+
+// EmailTemplatePreview is an email template rendered offline.
+type EmailTemplatePreview struct {
+	// The rendered subject.
+	Subject string
+
+	// The rendered body.
+	Body string
+
+	// The variables output by the subject or the body that are not defined in the context, sorted.
+	Undefined []string
+}
+
+// Preview renders the subject and the body of the template with the context, or with
+// SampleEmailTemplateContext if the context is nil, without sending any email.
+//
+// Only the subset of Liquid supported by email templates is available, so templates that
+// preview successfully may still be rejected when unsupported tags are used. Rendering fails
+// for templates that loop more than 10,000 times or render more than 256 KiB.
+func (e *EmailTemplate) Preview(c *EmailTemplateContext) (*EmailTemplatePreview, error) {
+	if syntax := e.GetSyntax(); syntax != "" && syntax != EmailTemplateSyntaxLiquid {
+		return nil, fmt.Errorf("email template %q uses the %q syntax, only %q can be previewed",
+			e.GetTemplate(), syntax, EmailTemplateSyntaxLiquid)
+	}
+	if c == nil {
+		c = SampleEmailTemplateContext()
+	}
+	variables := c.Variables()
+
+	subject, err := liquid.Render(e.GetSubject(), variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the subject of email template %q: %w", e.GetTemplate(), err)
+	}
+	body, err := liquid.Render(e.GetBody(), variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the body of email template %q: %w", e.GetTemplate(), err)
+	}
+
+	preview := &EmailTemplatePreview{Subject: subject.Output, Body: body.Output}
+	seen := map[string]bool{}
+	for _, name := range append(subject.Undefined, body.Undefined...) {
+		if !seen[name] {
+			seen[name] = true
+			preview.Undefined = append(preview.Undefined, name)
+		}
+	}
+	sort.Strings(preview.Undefined)
+
+	return preview, nil
+}
+
+// Validate renders the template like Preview and returns an error if it fails to render or
+// outputs undefined variables, to check templates before they are updated or replaced.
+func (e *EmailTemplate) Validate(c *EmailTemplateContext) error {
+	preview, err := e.Preview(c)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range preview.Undefined {
+		errs = append(errs, fmt.Errorf("email template %q outputs the undefined variable %q", e.GetTemplate(), name))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,78 @@
+package management
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestEmailTemplate_Preview(t *testing.T) {
+	t.Run("It renders the subject and the body with the sample context", func(t *testing.T) {
+		template := &EmailTemplate{
+			Template: auth0.String("verify_email"),
+			Syntax:   auth0.String("liquid"),
+			Subject:  auth0.String("Welcome to {{ application.name }}"),
+			Body: auth0.String(
+				`<img src="{{ organization.branding.logo_url }}">` +
+					`{% if user.email_verified %}Hi{% else %}Verify {{ user.email }}{% endif %}: ` +
+					`<a href="{{ url }}">{{ friendly_name | default: tenant }}</a>`,
+			),
+		}
+
+		preview, err := template.Preview(nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Welcome to My App", preview.Subject)
+		assert.Equal(
+			t,
+			`<img src="https://example.com/logo.png">Verify jane.doe@example.com: `+
+				`<a href="https://my-tenant.auth0.com/lo/verify_email?ticket=sample">My Company</a>`,
+			preview.Body,
+		)
+		assert.Empty(t, preview.Undefined)
+	})
+
+	t.Run("It reports undefined variables", func(t *testing.T) {
+		template := &EmailTemplate{
+			Template: auth0.String("welcome_email"),
+			Subject:  auth0.String("Hello {{ user.given_name }}"),
+			Body:     auth0.String("{{ user.nickname }} {{ organization.display_name }} {{ user.given_name }}"),
+		}
+		c := &EmailTemplateContext{
+			User:  &User{Nickname: auth0.String("jd")},
+			Extra: map[string]interface{}{"organization": map[string]interface{}{"display_name": "Acme"}},
+		}
+
+		preview, err := template.Preview(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Hello ", preview.Subject)
+		assert.Equal(t, "jd Acme ", preview.Body)
+		assert.Equal(t, []string{"user.given_name"}, preview.Undefined)
+		assert.EqualError(
+			t,
+			template.Validate(c),
+			`email template "welcome_email" outputs the undefined variable "user.given_name"`,
+		)
+	})
+
+	t.Run("It fails on templates that do not parse", func(t *testing.T) {
+		template := &EmailTemplate{
+			Template: auth0.String("reset_email"),
+			Body:     auth0.String("{% if user.name %}missing end"),
+		}
+
+		err := template.Validate(nil)
+		assert.EqualError(t, err, `failed to render the body of email template "reset_email": line 1: "if" is not closed`)
+	})
+
+	t.Run("It fails on templates that are not written in Liquid", func(t *testing.T) {
+		template := &EmailTemplate{Template: auth0.String("reset_email"), Syntax: auth0.String("handlebars")}
+
+		_, err := template.Preview(nil)
+		assert.EqualError(t, err, `email template "reset_email" uses the "handlebars" syntax, only "liquid" can be previewed`)
+	})
+}
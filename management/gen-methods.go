@@ -45,6 +45,9 @@ var (
 	skipStructs = []string{
 		"Management",
 		".*Manager",
+		// Options holding funcs, which cannot be encoded as JSON.
+		"EmailProviderVerifyOptions",
+		"LogStreamMonitorOptions",
 	}
 )
 
@@ -136,6 +136,12 @@ type Log struct {
 	LocationInfo map[string]interface{} `json:"location_info"`
 }
 
+// LogList is a list of Logs.
+type LogList struct {
+	List
+	Logs []*Log `json:"logs"`
+}
+
 // TypeName returns the type name of an Event Log.
 func (l *Log) TypeName() string {
 	if l.Type == nil {
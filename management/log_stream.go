@@ -203,6 +203,8 @@ type LogStreamManager manager
 
 // Create a log stream.
 //
+// The log stream is sent as is, use Validate to check it first.
+//
 // See: https://auth0.com/docs/api/management/v2#!/log-streams
 func (m *LogStreamManager) Create(ctx context.Context, l *LogStream, opts ...RequestOption) error {
 	return m.management.Request(ctx, "POST", m.management.URI("log-streams"), l, opts...)
@@ -231,6 +233,8 @@ func (m *LogStreamManager) List(ctx context.Context, opts ...RequestOption) (ls
 // Note: For log streams of type eventbridge and eventgrid, updating the sink is
 // not permitted.
 //
+// The log stream is sent as is, use ValidateUpdate to check it first.
+//
 // See: https://auth0.com/docs/api/management/v2#!/log-streams
 func (m *LogStreamManager) Update(ctx context.Context, id string, l *LogStream, opts ...RequestOption) (err error) {
 	return m.management.Request(ctx, "PATCH", m.management.URI("log-streams", id), l, opts...)
@@ -0,0 +1,362 @@
+package management
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+const (
+	// LogStreamStatusActive is the status of a log stream delivering events.
+	LogStreamStatusActive = "active"
+
+	// LogStreamStatusPaused is the status of a log stream paused by a user.
+	LogStreamStatusPaused = "paused"
+
+	// LogStreamStatusSuspended is the status of a log stream suspended by Auth0 after its sink failed.
+	LogStreamStatusSuspended = "suspended"
+
+	defaultLogStreamMonitorInterval      = time.Minute
+	defaultLogStreamResumeInitialBackoff = time.Minute
+	defaultLogStreamResumeMaxBackoff     = 30 * time.Minute
+	defaultLogStreamMaxResumeAttempts    = 5
+)
+
+// LogStreamStatusChange is a change of the status of a log stream observed by Monitor.
+type LogStreamStatusChange struct {
+	// The log stream, as listed.
+	LogStream *LogStream
+
+	// The previous status, empty when the log stream is first observed.
+	Previous string
+
+	// The current status.
+	Current string
+}
+
+// LogStreamResumeAttempt is an attempt of Monitor to resume a suspended log stream.
+type LogStreamResumeAttempt struct {
+	// The log stream being resumed.
+	LogStream *LogStream
+
+	// The number of the attempt, starting at 1.
+	Attempt int
+
+	// Why the attempt failed, nil if the log stream was resumed.
+	Err error
+
+	// Whether this was the last attempt allowed, after which the log stream is left suspended
+	// until it becomes active again.
+	LastAttempt bool
+}
+
+// LogStreamMonitorOptions configures Monitor.
+type LogStreamMonitorOptions struct {
+	// The time between two lists of the log streams. Defaults to 1 minute.
+	Interval time.Duration
+
+	// Called when the status of a log stream changes, including when Monitor resumes it. Log
+	// streams that are not active when first observed are reported with an empty previous status.
+	OnStatusChange func(change LogStreamStatusChange)
+
+	// Whether to update suspended log streams back to active.
+	AutoResume bool
+
+	// The time to wait before the second resume attempt, doubled after every attempt. Defaults to 1 minute.
+	ResumeInitialBackoff time.Duration
+
+	// The maximum time to wait between resume attempts. Defaults to 30 minutes.
+	ResumeMaxBackoff time.Duration
+
+	// The number of resume attempts after which a log stream is left suspended. Defaults to 5.
+	MaxResumeAttempts int
+
+	// Whether to check the sink of a log stream with CheckSink before resuming it, counting a
+	// failed check as a failed attempt.
+	CheckSinkBeforeResume bool
+
+	// The client used to check sinks. Defaults to a client with a 10 seconds timeout.
+	SinkClient *http.Client
+
+	// Called after every resume attempt.
+	OnResume func(attempt LogStreamResumeAttempt)
+
+	// Called when the log streams fail to be listed. Monitor keeps going after errors.
+	OnError func(err error)
+}
+
+// logStreamResumeState tracks the resume attempts of a suspended log stream.
+type logStreamResumeState struct {
+	attempts    int
+	nextAttempt time.Time
+}
+
+// logStreamMonitor holds the state of Monitor between two lists of the log streams.
+type logStreamMonitor struct {
+	manager  *LogStreamManager
+	options  LogStreamMonitorOptions
+	opts     []RequestOption
+	statuses map[string]string
+	resumes  map[string]*logStreamResumeState
+	now      func() time.Time
+}
+
+// Monitor lists the log streams every interval until the context is done, reporting status
+// changes and, if enabled, resuming suspended log streams with an exponential backoff.
+//
+// Monitor blocks, so it is usually run in its own goroutine. It returns the error of the context.
+func (m *LogStreamManager) Monitor(ctx context.Context, monitorOptions LogStreamMonitorOptions, opts ...RequestOption) error {
+	if monitorOptions.Interval <= 0 {
+		monitorOptions.Interval = defaultLogStreamMonitorInterval
+	}
+	if monitorOptions.ResumeInitialBackoff <= 0 {
+		monitorOptions.ResumeInitialBackoff = defaultLogStreamResumeInitialBackoff
+	}
+	if monitorOptions.ResumeMaxBackoff <= 0 {
+		monitorOptions.ResumeMaxBackoff = defaultLogStreamResumeMaxBackoff
+	}
+	if monitorOptions.MaxResumeAttempts <= 0 {
+		monitorOptions.MaxResumeAttempts = defaultLogStreamMaxResumeAttempts
+	}
+
+	monitor := &logStreamMonitor{
+		manager:  m,
+		options:  monitorOptions,
+		opts:     opts,
+		statuses: map[string]string{},
+		resumes:  map[string]*logStreamResumeState{},
+		now:      time.Now,
+	}
+
+	ticker := time.NewTicker(monitorOptions.Interval)
+	defer ticker.Stop()
+
+	for {
+		monitor.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// check lists the log streams once, reporting status changes and resuming suspended log streams.
+func (lm *logStreamMonitor) check(ctx context.Context) {
+	logStreams, err := lm.manager.List(ctx, lm.opts...)
+	if err != nil {
+		if ctx.Err() == nil && lm.options.OnError != nil {
+			lm.options.OnError(fmt.Errorf("failed to list log streams: %w", err))
+		}
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, ls := range logStreams {
+		id, status := ls.GetID(), ls.GetStatus()
+		seen[id] = true
+
+		previous, known := lm.statuses[id]
+		lm.statuses[id] = status
+		if status != previous && (known || status != LogStreamStatusActive) && lm.options.OnStatusChange != nil {
+			lm.options.OnStatusChange(LogStreamStatusChange{LogStream: ls, Previous: previous, Current: status})
+		}
+
+		if status != LogStreamStatusSuspended {
+			delete(lm.resumes, id)
+			continue
+		}
+		if lm.options.AutoResume {
+			lm.resume(ctx, ls)
+		}
+	}
+
+	// Forget the log streams that were deleted.
+	for id := range lm.statuses {
+		if !seen[id] {
+			delete(lm.statuses, id)
+			delete(lm.resumes, id)
+		}
+	}
+}
+
+// resume attempts to resume the suspended log stream, unless it is waiting for its backoff or
+// has no attempt left.
+func (lm *logStreamMonitor) resume(ctx context.Context, ls *LogStream) {
+	state, ok := lm.resumes[ls.GetID()]
+	if !ok {
+		state = &logStreamResumeState{}
+		lm.resumes[ls.GetID()] = state
+	}
+	if state.attempts >= lm.options.MaxResumeAttempts || lm.now().Before(state.nextAttempt) {
+		return
+	}
+
+	state.attempts++
+	backoff := lm.options.ResumeInitialBackoff
+	for i := 1; i < state.attempts && backoff < lm.options.ResumeMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > lm.options.ResumeMaxBackoff {
+		backoff = lm.options.ResumeMaxBackoff
+	}
+	state.nextAttempt = lm.now().Add(backoff)
+
+	var err error
+	if lm.options.CheckSinkBeforeResume {
+		err = ls.CheckSink(ctx, lm.options.SinkClient)
+	}
+	update := &LogStream{Status: auth0.String(LogStreamStatusActive)}
+	if err == nil {
+		err = lm.manager.Update(ctx, ls.GetID(), update, lm.opts...)
+	}
+
+	if lm.options.OnResume != nil {
+		lm.options.OnResume(LogStreamResumeAttempt{
+			LogStream:   ls,
+			Attempt:     state.attempts,
+			Err:         err,
+			LastAttempt: err != nil && state.attempts >= lm.options.MaxResumeAttempts,
+		})
+	}
+	if err != nil {
+		return
+	}
+
+	delete(lm.resumes, ls.GetID())
+	lm.statuses[ls.GetID()] = update.GetStatus()
+	if lm.options.OnStatusChange != nil {
+		lm.options.OnStatusChange(LogStreamStatusChange{
+			LogStream: update,
+			Previous:  ls.GetStatus(),
+			Current:   update.GetStatus(),
+		})
+	}
+}
+
+// CheckSink checks that the sink of the log stream can receive events, so that the log stream
+// is not suspended again once resumed.
+//
+// For `http` log streams it sends an empty batch of events to the endpoint, with the configured
+// content type, authorization and custom headers, and fails if the endpoint cannot be reached,
+// rejects the authorization or answers with an error. For `sumo` log streams it does the same
+// with the source address, and for `splunk` log streams it queries the health of the HTTP Event
+// Collector.
+//
+// The client defaults to a client with a 10 seconds timeout.
+func (ls *LogStream) CheckSink(ctx context.Context, client *http.Client) error {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	var request *http.Request
+	var err error
+	switch sink := ls.Sink.(type) {
+	case *LogStreamSinkHTTP:
+		request, err = httpSinkRequest(ctx, sink)
+	case *LogStreamSinkSumo:
+		request, err = sinkRequest(ctx, http.MethodPost, "sumoSourceAddress", sink.GetSourceAddress(), "")
+	case *LogStreamSinkSplunk:
+		request, err = splunkSinkRequest(ctx, sink)
+	default:
+		return fmt.Errorf("cannot check the sink of log stream %q of type %q", ls.GetName(), ls.GetType())
+	}
+	if err != nil {
+		return fmt.Errorf("invalid sink of log stream %q: %w", ls.GetName(), err)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("sink of log stream %q is unreachable: %w", ls.GetName(), err)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 1<<16))
+
+	switch {
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("sink of log stream %q rejected the authorization: %s", ls.GetName(), response.Status)
+	case response.StatusCode >= http.StatusBadRequest:
+		return fmt.Errorf("sink of log stream %q answered %s", ls.GetName(), response.Status)
+	}
+	return nil
+}
+
+func sinkRequest(ctx context.Context, method, field, endpoint, body string) (*http.Request, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("%s is required", field)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("%s must be an absolute HTTP URL, got %q", field, endpoint)
+	}
+
+	return http.NewRequestWithContext(ctx, method, u.String(), bytes.NewBufferString(body))
+}
+
+func httpSinkRequest(ctx context.Context, sink *LogStreamSinkHTTP) (*http.Request, error) {
+	// An empty batch in the format events are delivered in.
+	var body string
+	switch format := sink.GetContentFormat(); format {
+	case "", "JSONARRAY":
+		body = "[]"
+	case "JSONLINES":
+		body = ""
+	case "JSONOBJECT":
+		body = "{}"
+	default:
+		return nil, fmt.Errorf("httpContentFormat must be one of JSONARRAY, JSONLINES or JSONOBJECT, got %q", format)
+	}
+
+	request, err := sinkRequest(ctx, http.MethodPost, "httpEndpoint", sink.GetEndpoint(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := sink.GetContentType()
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	request.Header.Set("Content-Type", contentType)
+	if sink.GetAuthorization() != "" {
+		request.Header.Set("Authorization", sink.GetAuthorization())
+	}
+	if sink.CustomHeaders != nil {
+		for _, header := range *sink.CustomHeaders {
+			if header["header"] != "" {
+				request.Header.Set(header["header"], header["value"])
+			}
+		}
+	}
+
+	return request, nil
+}
+
+func splunkSinkRequest(ctx context.Context, sink *LogStreamSinkSplunk) (*http.Request, error) {
+	domain := strings.TrimSpace(sink.GetDomain())
+	if domain == "" {
+		return nil, fmt.Errorf("splunkDomain is required")
+	}
+
+	scheme := "http"
+	if sink.GetSecure() {
+		scheme = "https"
+	}
+	host := domain
+	if port := sink.GetPort(); port != "" {
+		host = net.JoinHostPort(domain, port)
+	}
+
+	return sinkRequest(ctx, http.MethodGet, "splunkDomain", scheme+"://"+host+"/services/collector/health", "")
+}
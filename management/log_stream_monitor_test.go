@@ -0,0 +1,348 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+// logStreamServer serves log streams whose statuses can be changed by tests.
+type logStreamServer struct {
+	mu       sync.Mutex
+	statuses map[string]string
+	// Whether updates fail, as when the sink is still down.
+	failUpdates bool
+	updates     []string
+}
+
+func (s *logStreamServer) setStatus(id, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[id] = status
+}
+
+func (s *logStreamServer) updateCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.updates)
+}
+
+func withLogStreamServer(t *testing.T, statuses map[string]string) (*Management, *logStreamServer) {
+	t.Helper()
+
+	server := &logStreamServer{statuses: statuses}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/log-streams":
+			var logStreams []*LogStream
+			for _, id := range []string{"lst_1", "lst_2"} {
+				if status, ok := server.statuses[id]; ok {
+					logStreams = append(logStreams, &LogStream{
+						ID:     auth0.String(id),
+						Name:   auth0.String("stream " + id),
+						Type:   auth0.String(LogStreamTypeHTTP),
+						Status: auth0.String(status),
+						Sink:   &LogStreamSinkHTTP{Endpoint: auth0.String("https://example.com/logs")},
+					})
+				}
+			}
+			writeJSON(t, w, logStreams)
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/api/v2/log-streams/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/log-streams/")
+			var update LogStream
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			server.updates = append(server.updates, id+" "+update.GetStatus())
+			if server.failUpdates {
+				w.WriteHeader(http.StatusInternalServerError)
+				writeJSON(t, w, map[string]interface{}{"statusCode": 500, "message": "sink unavailable"})
+				return
+			}
+			server.statuses[id] = update.GetStatus()
+			writeJSON(t, w, &LogStream{ID: auth0.String(id), Status: update.Status})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, WithInsecure(), WithRetries(0, nil))
+	require.NoError(t, err)
+
+	return m, server
+}
+
+func newTestLogStreamMonitor(m *Management, options LogStreamMonitorOptions, now *time.Time) *logStreamMonitor {
+	if options.ResumeInitialBackoff == 0 {
+		options.ResumeInitialBackoff = time.Minute
+	}
+	if options.ResumeMaxBackoff == 0 {
+		options.ResumeMaxBackoff = 3 * time.Minute
+	}
+	if options.MaxResumeAttempts == 0 {
+		options.MaxResumeAttempts = 3
+	}
+	return &logStreamMonitor{
+		manager:  m.LogStream,
+		options:  options,
+		statuses: map[string]string{},
+		resumes:  map[string]*logStreamResumeState{},
+		now:      func() time.Time { return *now },
+	}
+}
+
+func TestLogStreamManager_Monitor(t *testing.T) {
+	t.Run("Should report status changes", func(t *testing.T) {
+		m, server := withLogStreamServer(t, map[string]string{"lst_1": "active", "lst_2": "paused"})
+
+		var changes []string
+		now := time.Now()
+		monitor := newTestLogStreamMonitor(m, LogStreamMonitorOptions{
+			OnStatusChange: func(change LogStreamStatusChange) {
+				changes = append(changes, change.LogStream.GetID()+": "+change.Previous+" -> "+change.Current)
+			},
+		}, &now)
+
+		monitor.check(context.Background())
+		assert.Equal(t, []string{"lst_2:  -> paused"}, changes)
+
+		server.setStatus("lst_1", LogStreamStatusSuspended)
+		server.setStatus("lst_2", LogStreamStatusActive)
+		monitor.check(context.Background())
+		monitor.check(context.Background())
+
+		assert.Equal(t, []string{
+			"lst_2:  -> paused",
+			"lst_1: active -> suspended",
+			"lst_2: paused -> active",
+		}, changes)
+		assert.Zero(t, server.updateCount())
+	})
+
+	t.Run("Should resume suspended log streams", func(t *testing.T) {
+		m, server := withLogStreamServer(t, map[string]string{"lst_1": "suspended", "lst_2": "paused"})
+
+		var attempts []LogStreamResumeAttempt
+		var changes []string
+		now := time.Now()
+		monitor := newTestLogStreamMonitor(m, LogStreamMonitorOptions{
+			AutoResume: true,
+			OnResume:   func(attempt LogStreamResumeAttempt) { attempts = append(attempts, attempt) },
+			OnStatusChange: func(change LogStreamStatusChange) {
+				changes = append(changes, change.LogStream.GetID()+": "+change.Previous+" -> "+change.Current)
+			},
+		}, &now)
+
+		monitor.check(context.Background())
+		monitor.check(context.Background())
+
+		require.Len(t, attempts, 1)
+		assert.NoError(t, attempts[0].Err)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.Equal(t, []string{"lst_1 active"}, server.updates)
+		assert.Equal(t, []string{
+			"lst_1:  -> suspended",
+			"lst_1: suspended -> active",
+			"lst_2:  -> paused",
+		}, changes)
+	})
+
+	t.Run("Should back off and give up after the maximum attempts", func(t *testing.T) {
+		m, server := withLogStreamServer(t, map[string]string{"lst_1": "suspended"})
+		server.failUpdates = true
+
+		var attempts []LogStreamResumeAttempt
+		now := time.Now()
+		monitor := newTestLogStreamMonitor(m, LogStreamMonitorOptions{
+			AutoResume: true,
+			OnResume:   func(attempt LogStreamResumeAttempt) { attempts = append(attempts, attempt) },
+		}, &now)
+
+		// Attempts are made after 0, 1 and 2 more minutes, then the log stream is left suspended.
+		for _, wait := range []time.Duration{0, 30 * time.Second, 30 * time.Second, time.Minute, time.Minute, time.Hour} {
+			now = now.Add(wait)
+			monitor.check(context.Background())
+		}
+
+		require.Len(t, attempts, 3)
+		for i, attempt := range attempts {
+			assert.Equal(t, i+1, attempt.Attempt)
+			assert.ErrorContains(t, attempt.Err, "sink unavailable")
+			assert.Equal(t, i == 2, attempt.LastAttempt)
+		}
+		assert.Equal(t, 3, server.updateCount())
+
+		// Attempts start over once the log stream was active again.
+		server.setStatus("lst_1", LogStreamStatusActive)
+		monitor.check(context.Background())
+		server.setStatus("lst_1", LogStreamStatusSuspended)
+		monitor.check(context.Background())
+		assert.Equal(t, 4, server.updateCount())
+	})
+
+	t.Run("Should not resume log streams whose sink fails", func(t *testing.T) {
+		m, server := withLogStreamServer(t, map[string]string{"lst_1": "suspended"})
+
+		var attempts []LogStreamResumeAttempt
+		now := time.Now()
+		monitor := newTestLogStreamMonitor(m, LogStreamMonitorOptions{
+			AutoResume:            true,
+			CheckSinkBeforeResume: true,
+			SinkClient: &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway", Body: http.NoBody}, nil
+			})},
+			OnResume: func(attempt LogStreamResumeAttempt) { attempts = append(attempts, attempt) },
+		}, &now)
+
+		monitor.check(context.Background())
+
+		require.Len(t, attempts, 1)
+		assert.EqualError(t, attempts[0].Err, `sink of log stream "stream lst_1" answered 502 Bad Gateway`)
+		assert.Zero(t, server.updateCount())
+	})
+
+	t.Run("Should report list errors and stop when the context is done", func(t *testing.T) {
+		m, err := New("http://127.0.0.1:1", WithInsecure(), WithRetries(0, nil))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errs := make(chan error, 10)
+		done := make(chan error)
+		go func() {
+			done <- m.LogStream.Monitor(ctx, LogStreamMonitorOptions{
+				Interval: time.Millisecond,
+				OnError: func(err error) {
+					select {
+					case errs <- err:
+					default:
+					}
+				},
+			})
+		}()
+
+		assert.ErrorContains(t, <-errs, "failed to list log streams")
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestLogStream_CheckSink(t *testing.T) {
+	var received []*http.Request
+	var bodies []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = append(received, r)
+		bodies = append(bodies, string(body))
+
+		switch {
+		case r.URL.Path == "/services/collector/health":
+			w.WriteHeader(http.StatusOK)
+		case r.Header.Get("Authorization") != "Bearer token":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	t.Run("Should post an empty batch to HTTP sinks", func(t *testing.T) {
+		received, bodies = nil, nil
+		ls := &LogStream{
+			Name: auth0.String("webhook"),
+			Type: auth0.String(LogStreamTypeHTTP),
+			Sink: &LogStreamSinkHTTP{
+				Endpoint:      auth0.String(s.URL + "/logs"),
+				ContentFormat: auth0.String("JSONLINES"),
+				ContentType:   auth0.String("application/x-ndjson"),
+				Authorization: auth0.String("Bearer token"),
+				CustomHeaders: &[]map[string]string{{"header": "X-Tenant", "value": "acme"}},
+			},
+		}
+
+		require.NoError(t, ls.CheckSink(context.Background(), nil))
+		require.Len(t, received, 1)
+		assert.Equal(t, http.MethodPost, received[0].Method)
+		assert.Equal(t, "application/x-ndjson", received[0].Header.Get("Content-Type"))
+		assert.Equal(t, "acme", received[0].Header.Get("X-Tenant"))
+		assert.Equal(t, "", bodies[0])
+	})
+
+	t.Run("Should report rejected authorizations", func(t *testing.T) {
+		ls := &LogStream{
+			Name: auth0.String("webhook"),
+			Type: auth0.String(LogStreamTypeHTTP),
+			Sink: &LogStreamSinkHTTP{Endpoint: auth0.String(s.URL + "/logs"), Authorization: auth0.String("Bearer wrong")},
+		}
+
+		err := ls.CheckSink(context.Background(), nil)
+		assert.EqualError(t, err, `sink of log stream "webhook" rejected the authorization: 401 Unauthorized`)
+	})
+
+	t.Run("Should report unreachable endpoints", func(t *testing.T) {
+		ls := &LogStream{
+			Name: auth0.String("webhook"),
+			Type: auth0.String(LogStreamTypeHTTP),
+			Sink: &LogStreamSinkHTTP{Endpoint: auth0.String("http://127.0.0.1:1/logs")},
+		}
+
+		err := ls.CheckSink(context.Background(), nil)
+		assert.ErrorContains(t, err, `sink of log stream "webhook" is unreachable`)
+	})
+
+	t.Run("Should report invalid endpoints", func(t *testing.T) {
+		ls := &LogStream{
+			Name: auth0.String("webhook"),
+			Type: auth0.String(LogStreamTypeHTTP),
+			Sink: &LogStreamSinkHTTP{Endpoint: auth0.String("example.com/logs")},
+		}
+
+		err := ls.CheckSink(context.Background(), nil)
+		assert.EqualError(t, err, `invalid sink of log stream "webhook": httpEndpoint must be an absolute HTTP URL, got "example.com/logs"`)
+	})
+
+	t.Run("Should query the health of Splunk sinks", func(t *testing.T) {
+		received = nil
+		host, port, _ := strings.Cut(strings.TrimPrefix(s.URL, "http://"), ":")
+		ls := &LogStream{
+			Name: auth0.String("splunk"),
+			Type: auth0.String(LogStreamTypeSplunk),
+			Sink: &LogStreamSinkSplunk{Domain: auth0.String(host), Port: auth0.String(port), Secure: auth0.Bool(false)},
+		}
+
+		require.NoError(t, ls.CheckSink(context.Background(), nil))
+		require.Len(t, received, 1)
+		assert.Equal(t, http.MethodGet, received[0].Method)
+	})
+
+	t.Run("Should fail on sinks that cannot be checked", func(t *testing.T) {
+		ls := &LogStream{
+			Name: auth0.String("datadog"),
+			Type: auth0.String(LogStreamTypeDatadog),
+			Sink: &LogStreamSinkDatadog{},
+		}
+
+		err := ls.CheckSink(context.Background(), nil)
+		assert.EqualError(t, err, `cannot check the sink of log stream "datadog" of type "datadog"`)
+	})
+}
@@ -0,0 +1,305 @@
+package management
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+// LogStreamFilterTypeCategory is the type of the filters matching log events by category.
+const LogStreamFilterTypeCategory = "category"
+
+// LogStreamFilterCategory is a category of log events a LogStream can be filtered on.
+//
+// See: https://auth0.com/docs/customize/log-streams#filters
+type LogStreamFilterCategory string
+
+const (
+	// LogStreamFilterAuthAncillaryFail matches failed ancillary authentication events.
+	LogStreamFilterAuthAncillaryFail LogStreamFilterCategory = "auth.ancillary.fail"
+	// LogStreamFilterAuthAncillarySuccess matches successful ancillary authentication events.
+	LogStreamFilterAuthAncillarySuccess LogStreamFilterCategory = "auth.ancillary.success"
+	// LogStreamFilterAuthLoginFail matches failed logins.
+	LogStreamFilterAuthLoginFail LogStreamFilterCategory = "auth.login.fail"
+	// LogStreamFilterAuthLoginNotification matches login notifications.
+	LogStreamFilterAuthLoginNotification LogStreamFilterCategory = "auth.login.notification"
+	// LogStreamFilterAuthLoginSuccess matches successful logins.
+	LogStreamFilterAuthLoginSuccess LogStreamFilterCategory = "auth.login.success"
+	// LogStreamFilterAuthLogoutFail matches failed logouts.
+	LogStreamFilterAuthLogoutFail LogStreamFilterCategory = "auth.logout.fail"
+	// LogStreamFilterAuthLogoutSuccess matches successful logouts.
+	LogStreamFilterAuthLogoutSuccess LogStreamFilterCategory = "auth.logout.success"
+	// LogStreamFilterAuthSignupFail matches failed signups.
+	LogStreamFilterAuthSignupFail LogStreamFilterCategory = "auth.signup.fail"
+	// LogStreamFilterAuthSignupSuccess matches successful signups.
+	LogStreamFilterAuthSignupSuccess LogStreamFilterCategory = "auth.signup.success"
+	// LogStreamFilterAuthSilentAuthFail matches failed silent authentications.
+	LogStreamFilterAuthSilentAuthFail LogStreamFilterCategory = "auth.silent_auth.fail"
+	// LogStreamFilterAuthSilentAuthSuccess matches successful silent authentications.
+	LogStreamFilterAuthSilentAuthSuccess LogStreamFilterCategory = "auth.silent_auth.success"
+	// LogStreamFilterAuthTokenExchangeFail matches failed token exchanges.
+	LogStreamFilterAuthTokenExchangeFail LogStreamFilterCategory = "auth.token_exchange.fail"
+	// LogStreamFilterAuthTokenExchangeSuccess matches successful token exchanges.
+	LogStreamFilterAuthTokenExchangeSuccess LogStreamFilterCategory = "auth.token_exchange.success"
+	// LogStreamFilterManagementFail matches failed Management API operations.
+	LogStreamFilterManagementFail LogStreamFilterCategory = "management.fail"
+	// LogStreamFilterManagementSuccess matches successful Management API operations.
+	LogStreamFilterManagementSuccess LogStreamFilterCategory = "management.success"
+	// LogStreamFilterSystemNotification matches system notifications.
+	LogStreamFilterSystemNotification LogStreamFilterCategory = "system.notification"
+	// LogStreamFilterUserFail matches failed user operations.
+	LogStreamFilterUserFail LogStreamFilterCategory = "user.fail"
+	// LogStreamFilterUserNotification matches user notifications.
+	LogStreamFilterUserNotification LogStreamFilterCategory = "user.notification"
+	// LogStreamFilterUserSuccess matches successful user operations.
+	LogStreamFilterUserSuccess LogStreamFilterCategory = "user.success"
+	// LogStreamFilterOther matches the events of no other category.
+	LogStreamFilterOther LogStreamFilterCategory = "other"
+)
+
+// LogStreamFilters returns the Filters of a LogStream delivering only the events of the
+// categories, ignoring duplicates.
+func LogStreamFilters(categories ...LogStreamFilterCategory) *[]map[string]string {
+	filters := make([]map[string]string, 0, len(categories))
+	seen := map[LogStreamFilterCategory]bool{}
+	for _, category := range categories {
+		if seen[category] {
+			continue
+		}
+		seen[category] = true
+		filters = append(filters, map[string]string{"type": LogStreamFilterTypeCategory, "name": string(category)})
+	}
+	return &filters
+}
+
+// FilterCategories returns the categories the log stream is filtered on, or none if it delivers
+// all events.
+func (ls *LogStream) FilterCategories() []LogStreamFilterCategory {
+	if ls.Filters == nil {
+		return nil
+	}
+
+	var categories []LogStreamFilterCategory
+	for _, filter := range *ls.Filters {
+		if filter["type"] == LogStreamFilterTypeCategory {
+			categories = append(categories, LogStreamFilterCategory(filter["name"]))
+		}
+	}
+	return categories
+}
+
+// logStreamSink is implemented by the sinks of the log stream types, to validate their fields.
+type logStreamSink interface {
+	// validate returns the problems of the sink. When partial, the sink is part of an update, so
+	// fields that are not set are not required.
+	validate(partial bool) []error
+}
+
+// logStreamSinkTypes returns an empty sink of each log stream type.
+var logStreamSinkTypes = map[string]func() logStreamSink{
+	LogStreamTypeAmazonEventBridge: func() logStreamSink { return &LogStreamSinkAmazonEventBridge{} },
+	LogStreamTypeAzureEventGrid:    func() logStreamSink { return &LogStreamSinkAzureEventGrid{} },
+	LogStreamTypeHTTP:              func() logStreamSink { return &LogStreamSinkHTTP{} },
+	LogStreamTypeDatadog:           func() logStreamSink { return &LogStreamSinkDatadog{} },
+	LogStreamTypeSplunk:            func() logStreamSink { return &LogStreamSinkSplunk{} },
+	LogStreamTypeSumo:              func() logStreamSink { return &LogStreamSinkSumo{} },
+	LogStreamTypeMixpanel:          func() logStreamSink { return &LogStreamSinkMixpanel{} },
+	LogStreamTypeSegment:           func() logStreamSink { return &LogStreamSinkSegment{} },
+}
+
+// Validate checks the log stream before it is created: its type must be known, its sink must be
+// of the matching type with its required fields set, and its filters must be category filters.
+//
+// Log streams of types unknown to this package, whose sink is an untyped map, are not validated.
+func (ls *LogStream) Validate() error {
+	return ls.validate(false)
+}
+
+// ValidateUpdate checks the log stream before it is sent in an update, like Validate does, but
+// only checks the fields that are set. The sinks of eventbridge and eventgrid log streams cannot
+// be updated, so they must be left out, including from log streams that were read beforehand.
+func (ls *LogStream) ValidateUpdate() error {
+	return ls.validate(true)
+}
+
+// validate checks the log stream, only checking the fields that are set when partial.
+func (ls *LogStream) validate(partial bool) error {
+	var errs []error
+
+	newSink, known := logStreamSinkTypes[ls.GetType()]
+	switch {
+	case ls.Type == nil && !partial:
+		errs = append(errs, errors.New("type is required"))
+	case ls.Type != nil && !known:
+		// Sinks of unknown types cannot be validated.
+	case ls.Sink == nil && !partial:
+		errs = append(errs, errors.New("sink is required"))
+	case ls.Sink != nil:
+		sink, ok := ls.Sink.(logStreamSink)
+		if !ok {
+			if _, untyped := ls.Sink.(map[string]interface{}); !untyped || known {
+				errs = append(errs, fmt.Errorf("sink of type %T cannot be used by %q log streams", ls.Sink, ls.GetType()))
+			}
+			break
+		}
+		if ls.Type != nil {
+			if expected := newSink(); reflect.TypeOf(expected) != reflect.TypeOf(sink) {
+				errs = append(errs, fmt.Errorf("sink of type %T cannot be used by %q log streams, use a %T", sink, ls.GetType(), expected))
+				break
+			}
+		}
+		for _, err := range sink.validate(partial) {
+			errs = append(errs, fmt.Errorf("sink: %w", err))
+		}
+	}
+
+	if ls.Filters != nil {
+		for i, filter := range *ls.Filters {
+			if filter["type"] != LogStreamFilterTypeCategory {
+				errs = append(errs, fmt.Errorf("filters[%d]: type must be %q, got %q", i, LogStreamFilterTypeCategory, filter["type"]))
+			}
+			if filter["name"] == "" {
+				errs = append(errs, fmt.Errorf("filters[%d]: name is required", i))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sinkFields collects the problems of the fields of a sink.
+type sinkFields struct {
+	partial bool
+	errs    []error
+}
+
+// required checks that the field is set, unless the sink is partial, and returns whether it
+// should be validated further.
+func (f *sinkFields) required(name string, value *string) bool {
+	if value == nil {
+		if !f.partial {
+			f.errs = append(f.errs, fmt.Errorf("%s is required", name))
+		}
+		return false
+	}
+	if *value == "" {
+		f.errs = append(f.errs, fmt.Errorf("%s is required", name))
+		return false
+	}
+	return true
+}
+
+func (f *sinkFields) oneOf(name string, value *string, values ...string) {
+	if value == nil {
+		return
+	}
+	for _, v := range values {
+		if *value == v {
+			return
+		}
+	}
+	f.errs = append(f.errs, fmt.Errorf("%s must be one of %q, got %q", name, values, *value))
+}
+
+func (f *sinkFields) matches(name string, value *string, pattern *regexp.Regexp, description string) {
+	if value != nil && !pattern.MatchString(*value) {
+		f.errs = append(f.errs, fmt.Errorf("%s must be %s, got %q", name, description, *value))
+	}
+}
+
+func (f *sinkFields) url(name string, value *string) {
+	if value == nil {
+		return
+	}
+	if u, err := url.Parse(*value); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		f.errs = append(f.errs, fmt.Errorf("%s must be an absolute HTTP URL, got %q", name, *value))
+	}
+}
+
+var awsAccountIDPattern = regexp.MustCompile(`^\d{12}$`)
+
+func (s *LogStreamSinkAmazonEventBridge) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	if partial {
+		f.errs = append(f.errs, errors.New("eventbridge sinks cannot be updated"))
+		return f.errs
+	}
+	if f.required("awsAccountId", s.AccountID) {
+		f.matches("awsAccountId", s.AccountID, awsAccountIDPattern, "12 digits")
+	}
+	f.required("awsRegion", s.Region)
+	return f.errs
+}
+
+func (s *LogStreamSinkAzureEventGrid) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	if partial {
+		f.errs = append(f.errs, errors.New("eventgrid sinks cannot be updated"))
+		return f.errs
+	}
+	f.required("azureSubscriptionId", s.SubscriptionID)
+	f.required("azureResourceGroup", s.ResourceGroup)
+	f.required("azureRegion", s.Region)
+	return f.errs
+}
+
+func (s *LogStreamSinkHTTP) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	if f.required("httpEndpoint", s.Endpoint) {
+		f.url("httpEndpoint", s.Endpoint)
+	}
+	f.oneOf("httpContentFormat", s.ContentFormat, "JSONARRAY", "JSONLINES", "JSONOBJECT")
+	if s.CustomHeaders != nil {
+		for i, header := range *s.CustomHeaders {
+			if header["header"] == "" {
+				f.errs = append(f.errs, fmt.Errorf("httpCustomHeaders[%d]: header is required", i))
+			}
+		}
+	}
+	return f.errs
+}
+
+func (s *LogStreamSinkDatadog) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	f.required("datadogApiKey", s.APIKey)
+	f.required("datadogRegion", s.Region)
+	return f.errs
+}
+
+func (s *LogStreamSinkSplunk) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	f.required("splunkDomain", s.Domain)
+	f.required("splunkToken", s.Token)
+	if f.required("splunkPort", s.Port) {
+		if port, err := strconv.Atoi(*s.Port); err != nil || port <= 0 || port > 65535 {
+			f.errs = append(f.errs, fmt.Errorf("splunkPort must be a port number, got %q", *s.Port))
+		}
+	}
+	return f.errs
+}
+
+func (s *LogStreamSinkSumo) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	if f.required("sumoSourceAddress", s.SourceAddress) {
+		f.url("sumoSourceAddress", s.SourceAddress)
+	}
+	return f.errs
+}
+
+func (s *LogStreamSinkMixpanel) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	f.required("mixpanelRegion", s.Region)
+	f.required("mixpanelProjectId", s.ProjectID)
+	f.required("mixpanelServiceAccountUsername", s.ServiceAccountUsername)
+	f.required("mixpanelServiceAccountPassword", s.ServiceAccountPassword)
+	return f.errs
+}
+
+func (s *LogStreamSinkSegment) validate(partial bool) []error {
+	f := &sinkFields{partial: partial}
+	f.required("segmentWriteKey", s.WriteKey)
+	return f.errs
+}
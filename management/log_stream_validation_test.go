@@ -0,0 +1,177 @@
+package management
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestLogStreamFilters(t *testing.T) {
+	filters := LogStreamFilters(LogStreamFilterAuthLoginSuccess, LogStreamFilterUserNotification, LogStreamFilterAuthLoginSuccess)
+
+	assert.Equal(t, &[]map[string]string{
+		{"type": "category", "name": "auth.login.success"},
+		{"type": "category", "name": "user.notification"},
+	}, filters)
+
+	ls := &LogStream{Filters: filters}
+	assert.Equal(t, []LogStreamFilterCategory{LogStreamFilterAuthLoginSuccess, LogStreamFilterUserNotification}, ls.FilterCategories())
+	assert.Empty(t, (&LogStream{}).FilterCategories())
+}
+
+func TestLogStream_Validate(t *testing.T) {
+	for _, testCase := range logStreamTestCases {
+		t.Run("It accepts the "+testCase.name, func(t *testing.T) {
+			assert.NoError(t, testCase.logStream.Validate())
+		})
+	}
+
+	var testCases = []struct {
+		name      string
+		logStream *LogStream
+		expected  string
+	}{
+		{
+			name:      "missing type and sink",
+			logStream: &LogStream{Name: auth0.String("stream")},
+			expected:  "type is required",
+		},
+		{
+			name:      "missing sink",
+			logStream: &LogStream{Type: auth0.String(LogStreamTypeHTTP)},
+			expected:  "sink is required",
+		},
+		{
+			name: "sink of another type",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeDatadog),
+				Sink: &LogStreamSinkSegment{WriteKey: auth0.String("key")},
+			},
+			expected: `sink of type *management.LogStreamSinkSegment cannot be used by "datadog" log streams, use a *management.LogStreamSinkDatadog`,
+		},
+		{
+			name: "invalid http sink",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeHTTP),
+				Sink: &LogStreamSinkHTTP{
+					Endpoint:      auth0.String("example.com/logs"),
+					ContentFormat: auth0.String("XML"),
+				},
+			},
+			expected: `sink: httpEndpoint must be an absolute HTTP URL, got "example.com/logs"` + "\n" +
+				`sink: httpContentFormat must be one of ["JSONARRAY" "JSONLINES" "JSONOBJECT"], got "XML"`,
+		},
+		{
+			name: "invalid splunk sink",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeSplunk),
+				Sink: &LogStreamSinkSplunk{Domain: auth0.String("splunk.example.com"), Port: auth0.String("http")},
+			},
+			expected: "sink: splunkToken is required\n" + `sink: splunkPort must be a port number, got "http"`,
+		},
+		{
+			name: "invalid eventbridge sink",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeAmazonEventBridge),
+				Sink: &LogStreamSinkAmazonEventBridge{AccountID: auth0.String("123")},
+			},
+			expected: `sink: awsAccountId must be 12 digits, got "123"` + "\n" + "sink: awsRegion is required",
+		},
+		{
+			name: "invalid filters",
+			logStream: &LogStream{
+				Type:    auth0.String(LogStreamTypeSegment),
+				Sink:    &LogStreamSinkSegment{WriteKey: auth0.String("key")},
+				Filters: &[]map[string]string{{"type": "tag", "name": "auth.login.fail"}, {"type": "category"}},
+			},
+			expected: `filters[0]: type must be "category", got "tag"` + "\n" + "filters[1]: name is required",
+		},
+		{
+			name: "datadog region unknown to the SDK",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeDatadog),
+				Sink: &LogStreamSinkDatadog{APIKey: auth0.String("key"), Region: auth0.String("ap1")},
+			},
+		},
+		{
+			name: "empty mixpanel region",
+			logStream: &LogStream{
+				Type: auth0.String(LogStreamTypeMixpanel),
+				Sink: &LogStreamSinkMixpanel{
+					Region:                 auth0.String(""),
+					ProjectID:              auth0.String("123"),
+					ServiceAccountUsername: auth0.String("user"),
+					ServiceAccountPassword: auth0.String("password"),
+				},
+			},
+			expected: "sink: mixpanelRegion is required",
+		},
+		{
+			name: "unknown type",
+			logStream: &LogStream{
+				Type: auth0.String("newrelic"),
+				Sink: map[string]interface{}{"apiKey": "key"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("It checks "+testCase.name, func(t *testing.T) {
+			err := testCase.logStream.Validate()
+			if testCase.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+}
+
+func TestLogStream_ValidateUpdate(t *testing.T) {
+	err := (&LogStream{Sink: &LogStreamSinkAmazonEventBridge{Region: auth0.String("us-west-2")}}).ValidateUpdate()
+	assert.EqualError(t, err, "sink: eventbridge sinks cannot be updated")
+
+	// Updates only check the fields that are set.
+	err = (&LogStream{
+		Sink:    &LogStreamSinkHTTP{Authorization: auth0.String("Bearer token")},
+		Filters: LogStreamFilters(LogStreamFilterAuthLoginFail),
+	}).ValidateUpdate()
+	assert.NoError(t, err)
+
+	err = (&LogStream{Sink: &LogStreamSinkHTTP{Endpoint: auth0.String("example.com")}}).ValidateUpdate()
+	assert.EqualError(t, err, `sink: httpEndpoint must be an absolute HTTP URL, got "example.com"`)
+}
+
+func TestLogStreamManager_SendsWithoutValidating(t *testing.T) {
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, err := io.Copy(w, r.Body)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+
+	m, err := New(s.URL, WithInsecure())
+	require.NoError(t, err)
+
+	// Validation is left to Validate and ValidateUpdate, so log streams read beforehand can be
+	// sent back as is.
+	err = m.LogStream.Create(context.Background(), &LogStream{
+		Type: auth0.String(LogStreamTypeSumo),
+		Sink: &LogStreamSinkSumo{},
+	})
+	assert.NoError(t, err)
+
+	err = m.LogStream.Update(context.Background(), "lst_1", &LogStream{
+		Sink: &LogStreamSinkAmazonEventBridge{Region: auth0.String("us-west-2")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
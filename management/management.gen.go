@@ -187,6 +187,11 @@ func (a *ActionBindingReference) String() string {
 	return Stringify(a)
 }
 
+// String returns a string representation of ActionBuildError.
+func (a *ActionBuildError) String() string {
+	return Stringify(a)
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (a *ActionDependency) GetName() string {
 	if a == nil || a.Name == nil {
@@ -261,6 +266,93 @@ func (a *ActionExecution) String() string {
 	return Stringify(a)
 }
 
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionError) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionError) GetMessage() string {
+	if a == nil || a.Message == nil {
+		return ""
+	}
+	return *a.Message
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionError) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetStack returns the Stack field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionError) GetStack() string {
+	if a == nil || a.Stack == nil {
+		return ""
+	}
+	return *a.Stack
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionError) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// String returns a string representation of ActionExecutionError.
+func (a *ActionExecutionError) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of ActionExecutionReport.
+func (a *ActionExecutionReport) String() string {
+	return Stringify(a)
+}
+
+// GetData returns the Data map if it's non-nil, an empty map otherwise.
+func (a *ActionExecutionResponse) GetData() map[string]interface{} {
+	if a == nil || a.Data == nil {
+		return map[string]interface{}{}
+	}
+	return a.Data
+}
+
+// GetError returns the Error field.
+func (a *ActionExecutionResponse) GetError() *ActionExecutionError {
+	if a == nil {
+		return nil
+	}
+	return a.Error
+}
+
+// GetLogs returns the Logs field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionResponse) GetLogs() string {
+	if a == nil || a.Logs == nil {
+		return ""
+	}
+	return *a.Logs
+}
+
+// GetStats returns the Stats field.
+func (a *ActionExecutionResponse) GetStats() *ActionExecutionStats {
+	if a == nil {
+		return nil
+	}
+	return a.Stats
+}
+
+// String returns a string representation of ActionExecutionResponse.
+func (a *ActionExecutionResponse) String() string {
+	return Stringify(a)
+}
+
 // GetActionName returns the ActionName field if it's non-nil, zero value otherwise.
 func (a *ActionExecutionResult) GetActionName() string {
 	if a == nil || a.ActionName == nil {
@@ -285,6 +377,14 @@ func (a *ActionExecutionResult) GetError() map[string]interface{} {
 	return a.Error
 }
 
+// GetResponse returns the Response field.
+func (a *ActionExecutionResult) GetResponse() *ActionExecutionResponse {
+	if a == nil {
+		return nil
+	}
+	return a.Response
+}
+
 // GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
 func (a *ActionExecutionResult) GetStartedAt() time.Time {
 	if a == nil || a.StartedAt == nil {
@@ -298,11 +398,114 @@ func (a *ActionExecutionResult) String() string {
 	return Stringify(a)
 }
 
+// GetActionDuration returns the ActionDuration field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionStats) GetActionDuration() int {
+	if a == nil || a.ActionDuration == nil {
+		return 0
+	}
+	return *a.ActionDuration
+}
+
+// GetBootDuration returns the BootDuration field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionStats) GetBootDuration() int {
+	if a == nil || a.BootDuration == nil {
+		return 0
+	}
+	return *a.BootDuration
+}
+
+// GetTotalRequestDuration returns the TotalRequestDuration field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionStats) GetTotalRequestDuration() int {
+	if a == nil || a.TotalRequestDuration == nil {
+		return 0
+	}
+	return *a.TotalRequestDuration
+}
+
+// GetTotalRuntimeExecutionDuration returns the TotalRuntimeExecutionDuration field if it's non-nil, zero value otherwise.
+func (a *ActionExecutionStats) GetTotalRuntimeExecutionDuration() int {
+	if a == nil || a.TotalRuntimeExecutionDuration == nil {
+		return 0
+	}
+	return *a.TotalRuntimeExecutionDuration
+}
+
+// String returns a string representation of ActionExecutionStats.
+func (a *ActionExecutionStats) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of ActionExecutionSummary.
+func (a *ActionExecutionSummary) String() string {
+	return Stringify(a)
+}
+
 // String returns a string representation of ActionList.
 func (a *ActionList) String() string {
 	return Stringify(a)
 }
 
+// GetActionID returns the ActionID field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetActionID() string {
+	if a == nil || a.ActionID == nil {
+		return ""
+	}
+	return *a.ActionID
+}
+
+// GetActionName returns the ActionName field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetActionName() string {
+	if a == nil || a.ActionName == nil {
+		return ""
+	}
+	return *a.ActionName
+}
+
+// GetExecutionID returns the ExecutionID field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetExecutionID() string {
+	if a == nil || a.ExecutionID == nil {
+		return ""
+	}
+	return *a.ExecutionID
+}
+
+// GetLevel returns the Level field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetLevel() string {
+	if a == nil || a.Level == nil {
+		return ""
+	}
+	return *a.Level
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetMessage() string {
+	if a == nil || a.Message == nil {
+		return ""
+	}
+	return *a.Message
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetTimestamp() time.Time {
+	if a == nil || a.Timestamp == nil {
+		return time.Time{}
+	}
+	return *a.Timestamp
+}
+
+// GetTriggerID returns the TriggerID field if it's non-nil, zero value otherwise.
+func (a *ActionLogLine) GetTriggerID() string {
+	if a == nil || a.TriggerID == nil {
+		return ""
+	}
+	return *a.TriggerID
+}
+
+// String returns a string representation of ActionLogLine.
+func (a *ActionLogLine) String() string {
+	return Stringify(a)
+}
+
 // GetExpires returns the Expires field if it's non-nil, zero value otherwise.
 func (a *ActionLogSession) GetExpires() time.Time {
 	if a == nil || a.Expires == nil {
@@ -329,6 +532,29 @@ func (a *ActionLogSessionFilter) String() string {
 	return Stringify(a)
 }
 
+// String returns a string representation of ActionLogStream.
+func (a *ActionLogStream) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of ActionLogTailOptions.
+func (a *ActionLogTailOptions) String() string {
+	return Stringify(a)
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (a *ActionPublishOptions) GetPosition() int {
+	if a == nil || a.Position == nil {
+		return 0
+	}
+	return *a.Position
+}
+
+// String returns a string representation of ActionPublishOptions.
+func (a *ActionPublishOptions) String() string {
+	return Stringify(a)
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (a *ActionSecret) GetName() string {
 	if a == nil || a.Name == nil {
@@ -1008,129 +1234,414 @@ func (b *BrandingPageBackgroundGradient) String() string {
 	return Stringify(b)
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (b *BrandingTheme) GetDisplayName() string {
-	if b == nil || b.DisplayName == nil {
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplate) GetChannel() string {
+	if b == nil || b.Channel == nil {
 		return ""
 	}
-	return *b.DisplayName
+	return *b.Channel
+}
+
+// GetContent returns the Content field.
+func (b *BrandingPhoneNotificationTemplate) GetContent() *BrandingPhoneNotificationTemplateContent {
+	if b == nil {
+		return nil
+	}
+	return b.Content
+}
+
+// GetCustomizable returns the Customizable field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplate) GetCustomizable() bool {
+	if b == nil || b.Customizable == nil {
+		return false
+	}
+	return *b.Customizable
+}
+
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplate) GetDisabled() bool {
+	if b == nil || b.Disabled == nil {
+		return false
+	}
+	return *b.Disabled
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BrandingTheme) GetID() string {
+func (b *BrandingPhoneNotificationTemplate) GetID() string {
 	if b == nil || b.ID == nil {
 		return ""
 	}
 	return *b.ID
 }
 
-// String returns a string representation of BrandingTheme.
-func (b *BrandingTheme) String() string {
-	return Stringify(b)
+// GetTenant returns the Tenant field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplate) GetTenant() string {
+	if b == nil || b.Tenant == nil {
+		return ""
+	}
+	return *b.Tenant
 }
 
-// String returns a string representation of BrandingThemeBorders.
-func (b *BrandingThemeBorders) String() string {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplate) GetType() string {
+	if b == nil || b.Type == nil {
+		return ""
+	}
+	return *b.Type
+}
+
+// String returns a string representation of BrandingPhoneNotificationTemplate.
+func (b *BrandingPhoneNotificationTemplate) String() string {
 	return Stringify(b)
 }
 
-// GetBaseFocusColor returns the BaseFocusColor field if it's non-nil, zero value otherwise.
-func (b *BrandingThemeColors) GetBaseFocusColor() string {
-	if b == nil || b.BaseFocusColor == nil {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplateBody) GetText() string {
+	if b == nil || b.Text == nil {
 		return ""
 	}
-	return *b.BaseFocusColor
+	return *b.Text
 }
 
-// GetBaseHoverColor returns the BaseHoverColor field if it's non-nil, zero value otherwise.
-func (b *BrandingThemeColors) GetBaseHoverColor() string {
-	if b == nil || b.BaseHoverColor == nil {
+// GetVoice returns the Voice field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplateBody) GetVoice() string {
+	if b == nil || b.Voice == nil {
 		return ""
 	}
-	return *b.BaseHoverColor
+	return *b.Voice
 }
 
-// String returns a string representation of BrandingThemeColors.
-func (b *BrandingThemeColors) String() string {
+// String returns a string representation of BrandingPhoneNotificationTemplateBody.
+func (b *BrandingPhoneNotificationTemplateBody) String() string {
 	return Stringify(b)
 }
 
-// String returns a string representation of BrandingThemeFonts.
-func (b *BrandingThemeFonts) String() string {
-	return Stringify(b)
+// GetBody returns the Body field.
+func (b *BrandingPhoneNotificationTemplateContent) GetBody() *BrandingPhoneNotificationTemplateBody {
+	if b == nil {
+		return nil
+	}
+	return b.Body
 }
 
-// String returns a string representation of BrandingThemePageBackground.
-func (b *BrandingThemePageBackground) String() string {
-	return Stringify(b)
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplateContent) GetFrom() string {
+	if b == nil || b.From == nil {
+		return ""
+	}
+	return *b.From
 }
 
-// String returns a string representation of BrandingThemeText.
-func (b *BrandingThemeText) String() string {
+// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneNotificationTemplateContent) GetSyntax() string {
+	if b == nil || b.Syntax == nil {
+		return ""
+	}
+	return *b.Syntax
+}
+
+// String returns a string representation of BrandingPhoneNotificationTemplateContent.
+func (b *BrandingPhoneNotificationTemplateContent) String() string {
 	return Stringify(b)
 }
 
-// String returns a string representation of BrandingThemeWidget.
-func (b *BrandingThemeWidget) String() string {
+// String returns a string representation of BrandingPhoneNotificationTemplateList.
+func (b *BrandingPhoneNotificationTemplateList) String() string {
 	return Stringify(b)
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (b *BrandingUniversalLogin) GetBody() string {
-	if b == nil || b.Body == nil {
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetChannel() string {
+	if b == nil || b.Channel == nil {
 		return ""
 	}
-	return *b.Body
+	return *b.Channel
 }
 
-// String returns a string representation of BrandingUniversalLogin.
-func (b *BrandingUniversalLogin) String() string {
-	return Stringify(b)
+// GetConfiguration returns the Configuration field.
+func (b *BrandingPhoneProvider) GetConfiguration() *BrandingPhoneProviderConfiguration {
+	if b == nil {
+		return nil
+	}
+	return b.Configuration
 }
 
-// GetAdminNotificationFrequency returns the AdminNotificationFrequency field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetAdminNotificationFrequency() []string {
-	if b == nil || b.AdminNotificationFrequency == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetCreatedAt() time.Time {
+	if b == nil || b.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *b.CreatedAt
+}
+
+// GetCredentials returns the Credentials field.
+func (b *BrandingPhoneProvider) GetCredentials() *BrandingPhoneProviderCredentials {
+	if b == nil {
 		return nil
 	}
-	return *b.AdminNotificationFrequency
+	return b.Credentials
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetEnabled() bool {
-	if b == nil || b.Enabled == nil {
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetDisabled() bool {
+	if b == nil || b.Disabled == nil {
 		return false
 	}
-	return *b.Enabled
+	return *b.Disabled
 }
 
-// GetMethod returns the Method field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetMethod() string {
-	if b == nil || b.Method == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetID() string {
+	if b == nil || b.ID == nil {
 		return ""
 	}
-	return *b.Method
+	return *b.ID
 }
 
-// GetShields returns the Shields field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetShields() []string {
-	if b == nil || b.Shields == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return *b.Shields
+	return *b.Name
 }
 
-// GetStage returns the Stage field.
-func (b *BreachedPasswordDetection) GetStage() *BreachedPasswordDetectionStage {
-	if b == nil {
-		return nil
+// GetTenant returns the Tenant field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetTenant() string {
+	if b == nil || b.Tenant == nil {
+		return ""
 	}
-	return b.Stage
+	return *b.Tenant
 }
 
-// String returns a string representation of BreachedPasswordDetection.
-func (b *BreachedPasswordDetection) String() string {
-	return Stringify(b)
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProvider) GetUpdatedAt() time.Time {
+	if b == nil || b.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *b.UpdatedAt
+}
+
+// String returns a string representation of BrandingPhoneProvider.
+func (b *BrandingPhoneProvider) String() string {
+	return Stringify(b)
+}
+
+// GetDefaultFrom returns the DefaultFrom field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProviderConfiguration) GetDefaultFrom() string {
+	if b == nil || b.DefaultFrom == nil {
+		return ""
+	}
+	return *b.DefaultFrom
+}
+
+// GetDeliveryMethods returns the DeliveryMethods field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProviderConfiguration) GetDeliveryMethods() []string {
+	if b == nil || b.DeliveryMethods == nil {
+		return nil
+	}
+	return *b.DeliveryMethods
+}
+
+// GetMSSID returns the MSSID field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProviderConfiguration) GetMSSID() string {
+	if b == nil || b.MSSID == nil {
+		return ""
+	}
+	return *b.MSSID
+}
+
+// GetSID returns the SID field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProviderConfiguration) GetSID() string {
+	if b == nil || b.SID == nil {
+		return ""
+	}
+	return *b.SID
+}
+
+// String returns a string representation of BrandingPhoneProviderConfiguration.
+func (b *BrandingPhoneProviderConfiguration) String() string {
+	return Stringify(b)
+}
+
+// GetAuthToken returns the AuthToken field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneProviderCredentials) GetAuthToken() string {
+	if b == nil || b.AuthToken == nil {
+		return ""
+	}
+	return *b.AuthToken
+}
+
+// String returns a string representation of BrandingPhoneProviderCredentials.
+func (b *BrandingPhoneProviderCredentials) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingPhoneProviderList.
+func (b *BrandingPhoneProviderList) String() string {
+	return Stringify(b)
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneTestNotification) GetCode() int {
+	if b == nil || b.Code == nil {
+		return 0
+	}
+	return *b.Code
+}
+
+// GetDeliveryMethod returns the DeliveryMethod field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneTestNotification) GetDeliveryMethod() string {
+	if b == nil || b.DeliveryMethod == nil {
+		return ""
+	}
+	return *b.DeliveryMethod
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneTestNotification) GetMessage() string {
+	if b == nil || b.Message == nil {
+		return ""
+	}
+	return *b.Message
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (b *BrandingPhoneTestNotification) GetTo() string {
+	if b == nil || b.To == nil {
+		return ""
+	}
+	return *b.To
+}
+
+// String returns a string representation of BrandingPhoneTestNotification.
+func (b *BrandingPhoneTestNotification) String() string {
+	return Stringify(b)
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (b *BrandingTheme) GetDisplayName() string {
+	if b == nil || b.DisplayName == nil {
+		return ""
+	}
+	return *b.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BrandingTheme) GetID() string {
+	if b == nil || b.ID == nil {
+		return ""
+	}
+	return *b.ID
+}
+
+// String returns a string representation of BrandingTheme.
+func (b *BrandingTheme) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingThemeBorders.
+func (b *BrandingThemeBorders) String() string {
+	return Stringify(b)
+}
+
+// GetBaseFocusColor returns the BaseFocusColor field if it's non-nil, zero value otherwise.
+func (b *BrandingThemeColors) GetBaseFocusColor() string {
+	if b == nil || b.BaseFocusColor == nil {
+		return ""
+	}
+	return *b.BaseFocusColor
+}
+
+// GetBaseHoverColor returns the BaseHoverColor field if it's non-nil, zero value otherwise.
+func (b *BrandingThemeColors) GetBaseHoverColor() string {
+	if b == nil || b.BaseHoverColor == nil {
+		return ""
+	}
+	return *b.BaseHoverColor
+}
+
+// String returns a string representation of BrandingThemeColors.
+func (b *BrandingThemeColors) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingThemeFonts.
+func (b *BrandingThemeFonts) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingThemePageBackground.
+func (b *BrandingThemePageBackground) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingThemeText.
+func (b *BrandingThemeText) String() string {
+	return Stringify(b)
+}
+
+// String returns a string representation of BrandingThemeWidget.
+func (b *BrandingThemeWidget) String() string {
+	return Stringify(b)
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (b *BrandingUniversalLogin) GetBody() string {
+	if b == nil || b.Body == nil {
+		return ""
+	}
+	return *b.Body
+}
+
+// String returns a string representation of BrandingUniversalLogin.
+func (b *BrandingUniversalLogin) String() string {
+	return Stringify(b)
+}
+
+// GetAdminNotificationFrequency returns the AdminNotificationFrequency field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetAdminNotificationFrequency() []string {
+	if b == nil || b.AdminNotificationFrequency == nil {
+		return nil
+	}
+	return *b.AdminNotificationFrequency
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
+	}
+	return *b.Enabled
+}
+
+// GetMethod returns the Method field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetMethod() string {
+	if b == nil || b.Method == nil {
+		return ""
+	}
+	return *b.Method
+}
+
+// GetShields returns the Shields field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetShields() []string {
+	if b == nil || b.Shields == nil {
+		return nil
+	}
+	return *b.Shields
+}
+
+// GetStage returns the Stage field.
+func (b *BreachedPasswordDetection) GetStage() *BreachedPasswordDetectionStage {
+	if b == nil {
+		return nil
+	}
+	return b.Stage
+}
+
+// String returns a string representation of BreachedPasswordDetection.
+func (b *BreachedPasswordDetection) String() string {
+	return Stringify(b)
 }
 
 // GetShields returns the Shields field if it's non-nil, zero value otherwise.
@@ -1763,6 +2274,14 @@ func (c *ClientAuthenticationMethods) String() string {
 	return Stringify(c)
 }
 
+// GetAllowAnyOrganization returns the AllowAnyOrganization field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetAllowAnyOrganization() bool {
+	if c == nil || c.AllowAnyOrganization == nil {
+		return false
+	}
+	return *c.AllowAnyOrganization
+}
+
 // GetAudience returns the Audience field if it's non-nil, zero value otherwise.
 func (c *ClientGrant) GetAudience() string {
 	if c == nil || c.Audience == nil {
@@ -1787,6 +2306,14 @@ func (c *ClientGrant) GetID() string {
 	return *c.ID
 }
 
+// GetOrganizationUsage returns the OrganizationUsage field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetOrganizationUsage() string {
+	if c == nil || c.OrganizationUsage == nil {
+		return ""
+	}
+	return *c.OrganizationUsage
+}
+
 // String returns a string representation of ClientGrant.
 func (c *ClientGrant) String() string {
 	return Stringify(c)
@@ -5863,11 +6390,21 @@ func (c *CustomDomain) String() string {
 	return Stringify(c)
 }
 
+// String returns a string representation of CustomDomainDNSRecord.
+func (c *CustomDomainDNSRecord) String() string {
+	return Stringify(c)
+}
+
 // String returns a string representation of CustomDomainVerification.
 func (c *CustomDomainVerification) String() string {
 	return Stringify(c)
 }
 
+// String returns a string representation of CustomDomainVerifyOptions.
+func (c *CustomDomainVerifyOptions) String() string {
+	return Stringify(c)
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (d *DailyStat) GetCreatedAt() time.Time {
 	if d == nil || d.CreatedAt == nil {
@@ -5921,6 +6458,72 @@ func (d *DailyStat) String() string {
 	return Stringify(d)
 }
 
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetClientID() string {
+	if d == nil || d.ClientID == nil {
+		return ""
+	}
+	return *d.ClientID
+}
+
+// GetDeviceID returns the DeviceID field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetDeviceID() string {
+	if d == nil || d.DeviceID == nil {
+		return ""
+	}
+	return *d.DeviceID
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetDeviceName() string {
+	if d == nil || d.DeviceName == nil {
+		return ""
+	}
+	return *d.DeviceName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
+	}
+	return *d.ID
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetUserID() string {
+	if d == nil || d.UserID == nil {
+		return ""
+	}
+	return *d.UserID
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (d *DeviceCredential) GetValue() string {
+	if d == nil || d.Value == nil {
+		return ""
+	}
+	return *d.Value
+}
+
+// String returns a string representation of DeviceCredential.
+func (d *DeviceCredential) String() string {
+	return Stringify(d)
+}
+
+// String returns a string representation of DeviceCredentialList.
+func (d *DeviceCredentialList) String() string {
+	return Stringify(d)
+}
+
 // String returns a string representation of DropboxClientAddon.
 func (d *DropboxClientAddon) String() string {
 	return Stringify(d)
@@ -5981,6 +6584,11 @@ func (e *EmailProvider) String() string {
 	return Stringify(e)
 }
 
+// String returns a string representation of EmailProviderCheck.
+func (e *EmailProviderCheck) String() string {
+	return Stringify(e)
+}
+
 // GetConnectionString returns the ConnectionString field if it's non-nil, zero value otherwise.
 func (e *EmailProviderCredentialsAzureCS) GetConnectionString() string {
 	if e == nil || e.ConnectionString == nil {
@@ -6251,6 +6859,11 @@ func (e *EmailProviderSettingsSMTPHeaders) String() string {
 	return Stringify(e)
 }
 
+// String returns a string representation of EmailProviderVerification.
+func (e *EmailProviderVerification) String() string {
+	return Stringify(e)
+}
+
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (e *EmailTemplate) GetBody() string {
 	if e == nil || e.Body == nil {
@@ -6328,24 +6941,114 @@ func (e *EmailTemplate) String() string {
 	return Stringify(e)
 }
 
-// GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetEnrolledAt() time.Time {
-	if e == nil || e.EnrolledAt == nil {
-		return time.Time{}
+// GetClient returns the Client field.
+func (e *EmailTemplateContext) GetClient() *Client {
+	if e == nil {
+		return nil
 	}
-	return *e.EnrolledAt
+	return e.Client
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetID() string {
-	if e == nil || e.ID == nil {
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (e *EmailTemplateContext) GetCode() string {
+	if e == nil || e.Code == nil {
 		return ""
 	}
-	return *e.ID
+	return *e.Code
 }
 
-// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetIdentifier() string {
+// GetConnectionName returns the ConnectionName field if it's non-nil, zero value otherwise.
+func (e *EmailTemplateContext) GetConnectionName() string {
+	if e == nil || e.ConnectionName == nil {
+		return ""
+	}
+	return *e.ConnectionName
+}
+
+// GetExtra returns the Extra map if it's non-nil, an empty map otherwise.
+func (e *EmailTemplateContext) GetExtra() map[string]interface{} {
+	if e == nil || e.Extra == nil {
+		return map[string]interface{}{}
+	}
+	return e.Extra
+}
+
+// GetOrganization returns the Organization field.
+func (e *EmailTemplateContext) GetOrganization() *Organization {
+	if e == nil {
+		return nil
+	}
+	return e.Organization
+}
+
+// GetRequestLanguage returns the RequestLanguage field if it's non-nil, zero value otherwise.
+func (e *EmailTemplateContext) GetRequestLanguage() string {
+	if e == nil || e.RequestLanguage == nil {
+		return ""
+	}
+	return *e.RequestLanguage
+}
+
+// GetTenant returns the Tenant field.
+func (e *EmailTemplateContext) GetTenant() *Tenant {
+	if e == nil {
+		return nil
+	}
+	return e.Tenant
+}
+
+// GetTenantName returns the TenantName field if it's non-nil, zero value otherwise.
+func (e *EmailTemplateContext) GetTenantName() string {
+	if e == nil || e.TenantName == nil {
+		return ""
+	}
+	return *e.TenantName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (e *EmailTemplateContext) GetURL() string {
+	if e == nil || e.URL == nil {
+		return ""
+	}
+	return *e.URL
+}
+
+// GetUser returns the User field.
+func (e *EmailTemplateContext) GetUser() *User {
+	if e == nil {
+		return nil
+	}
+	return e.User
+}
+
+// String returns a string representation of EmailTemplateContext.
+func (e *EmailTemplateContext) String() string {
+	return Stringify(e)
+}
+
+// String returns a string representation of EmailTemplatePreview.
+func (e *EmailTemplatePreview) String() string {
+	return Stringify(e)
+}
+
+// GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetEnrolledAt() time.Time {
+	if e == nil || e.EnrolledAt == nil {
+		return time.Time{}
+	}
+	return *e.EnrolledAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetIdentifier() string {
 	if e == nil || e.Identifier == nil {
 		return ""
 	}
@@ -6982,6 +7685,11 @@ func (l *Log) String() string {
 	return Stringify(l)
 }
 
+// String returns a string representation of LogList.
+func (l *LogList) String() string {
+	return Stringify(l)
+}
+
 // GetFilters returns the Filters field if it's non-nil, zero value otherwise.
 func (l *LogStream) GetFilters() []map[string]string {
 	if l == nil || l.Filters == nil {
@@ -7027,6 +7735,19 @@ func (l *LogStream) String() string {
 	return Stringify(l)
 }
 
+// GetLogStream returns the LogStream field.
+func (l *LogStreamResumeAttempt) GetLogStream() *LogStream {
+	if l == nil {
+		return nil
+	}
+	return l.LogStream
+}
+
+// String returns a string representation of LogStreamResumeAttempt.
+func (l *LogStreamResumeAttempt) String() string {
+	return Stringify(l)
+}
+
 // GetAccountID returns the AccountID field if it's non-nil, zero value otherwise.
 func (l *LogStreamSinkAmazonEventBridge) GetAccountID() string {
 	if l == nil || l.AccountID == nil {
@@ -7259,6 +7980,24 @@ func (l *LogStreamSinkSumo) String() string {
 	return Stringify(l)
 }
 
+// GetLogStream returns the LogStream field.
+func (l *LogStreamStatusChange) GetLogStream() *LogStream {
+	if l == nil {
+		return nil
+	}
+	return l.LogStream
+}
+
+// String returns a string representation of LogStreamStatusChange.
+func (l *LogStreamStatusChange) String() string {
+	return Stringify(l)
+}
+
+// String returns a string representation of MemoryDNSProvider.
+func (m *MemoryDNSProvider) String() string {
+	return Stringify(m)
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (m *MSCRMClientAddon) GetURL() string {
 	if m == nil || m.URL == nil {
@@ -8158,6 +8897,162 @@ func (p *Prompt) String() string {
 	return Stringify(p)
 }
 
+// GetFormContentEnd returns the FormContentEnd field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetFormContentEnd() string {
+	if p == nil || p.FormContentEnd == nil {
+		return ""
+	}
+	return *p.FormContentEnd
+}
+
+// GetFormContentStart returns the FormContentStart field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetFormContentStart() string {
+	if p == nil || p.FormContentStart == nil {
+		return ""
+	}
+	return *p.FormContentStart
+}
+
+// GetFormFooterEnd returns the FormFooterEnd field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetFormFooterEnd() string {
+	if p == nil || p.FormFooterEnd == nil {
+		return ""
+	}
+	return *p.FormFooterEnd
+}
+
+// GetFormFooterStart returns the FormFooterStart field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetFormFooterStart() string {
+	if p == nil || p.FormFooterStart == nil {
+		return ""
+	}
+	return *p.FormFooterStart
+}
+
+// GetSecondaryActionsEnd returns the SecondaryActionsEnd field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetSecondaryActionsEnd() string {
+	if p == nil || p.SecondaryActionsEnd == nil {
+		return ""
+	}
+	return *p.SecondaryActionsEnd
+}
+
+// GetSecondaryActionsStart returns the SecondaryActionsStart field if it's non-nil, zero value otherwise.
+func (p *PromptScreenPartials) GetSecondaryActionsStart() string {
+	if p == nil || p.SecondaryActionsStart == nil {
+		return ""
+	}
+	return *p.SecondaryActionsStart
+}
+
+// String returns a string representation of PromptScreenPartials.
+func (p *PromptScreenPartials) String() string {
+	return Stringify(p)
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetClientID() string {
+	if r == nil || r.ClientID == nil {
+		return ""
+	}
+	return *r.ClientID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *r.CreatedAt
+}
+
+// GetDevice returns the Device field.
+func (r *RefreshToken) GetDevice() *SessionDevice {
+	if r == nil {
+		return nil
+	}
+	return r.Device
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetExpiresAt() time.Time {
+	if r == nil || r.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *r.ExpiresAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetIdleExpiresAt returns the IdleExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetIdleExpiresAt() time.Time {
+	if r == nil || r.IdleExpiresAt == nil {
+		return time.Time{}
+	}
+	return *r.IdleExpiresAt
+}
+
+// GetRotating returns the Rotating field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetRotating() bool {
+	if r == nil || r.Rotating == nil {
+		return false
+	}
+	return *r.Rotating
+}
+
+// GetSessionID returns the SessionID field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetSessionID() string {
+	if r == nil || r.SessionID == nil {
+		return ""
+	}
+	return *r.SessionID
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (r *RefreshToken) GetUserID() string {
+	if r == nil || r.UserID == nil {
+		return ""
+	}
+	return *r.UserID
+}
+
+// String returns a string representation of RefreshToken.
+func (r *RefreshToken) String() string {
+	return Stringify(r)
+}
+
+// String returns a string representation of RefreshTokenList.
+func (r *RefreshTokenList) String() string {
+	return Stringify(r)
+}
+
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (r *RefreshTokenResourceServer) GetAudience() string {
+	if r == nil || r.Audience == nil {
+		return ""
+	}
+	return *r.Audience
+}
+
+// GetScopes returns the Scopes field if it's non-nil, zero value otherwise.
+func (r *RefreshTokenResourceServer) GetScopes() string {
+	if r == nil || r.Scopes == nil {
+		return ""
+	}
+	return *r.Scopes
+}
+
+// String returns a string representation of RefreshTokenResourceServer.
+func (r *RefreshTokenResourceServer) String() string {
+	return Stringify(r)
+}
+
 // GetAllowOfflineAccess returns the AllowOfflineAccess field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetAllowOfflineAccess() bool {
 	if r == nil || r.AllowOfflineAccess == nil {
@@ -8482,295 +9377,926 @@ func (s *SalesforceSandboxAPIClientAddon) GetCommunityName() string {
 	if s == nil || s.CommunityName == nil {
 		return ""
 	}
-	return *s.CommunityName
+	return *s.CommunityName
+}
+
+// GetCommunityURLSection returns the CommunityURLSection field if it's non-nil, zero value otherwise.
+func (s *SalesforceSandboxAPIClientAddon) GetCommunityURLSection() string {
+	if s == nil || s.CommunityURLSection == nil {
+		return ""
+	}
+	return *s.CommunityURLSection
+}
+
+// GetPrincipal returns the Principal field if it's non-nil, zero value otherwise.
+func (s *SalesforceSandboxAPIClientAddon) GetPrincipal() string {
+	if s == nil || s.Principal == nil {
+		return ""
+	}
+	return *s.Principal
+}
+
+// String returns a string representation of SalesforceSandboxAPIClientAddon.
+func (s *SalesforceSandboxAPIClientAddon) String() string {
+	return Stringify(s)
+}
+
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetAudience() string {
+	if s == nil || s.Audience == nil {
+		return ""
+	}
+	return *s.Audience
+}
+
+// GetAuthnContextClassRef returns the AuthnContextClassRef field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetAuthnContextClassRef() string {
+	if s == nil || s.AuthnContextClassRef == nil {
+		return ""
+	}
+	return *s.AuthnContextClassRef
+}
+
+// GetBinding returns the Binding field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetBinding() string {
+	if s == nil || s.Binding == nil {
+		return ""
+	}
+	return *s.Binding
+}
+
+// GetCreateUPNClaim returns the CreateUPNClaim field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetCreateUPNClaim() bool {
+	if s == nil || s.CreateUPNClaim == nil {
+		return false
+	}
+	return *s.CreateUPNClaim
+}
+
+// GetDestination returns the Destination field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetDestination() string {
+	if s == nil || s.Destination == nil {
+		return ""
+	}
+	return *s.Destination
+}
+
+// GetDigestAlgorithm returns the DigestAlgorithm field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetDigestAlgorithm() string {
+	if s == nil || s.DigestAlgorithm == nil {
+		return ""
+	}
+	return *s.DigestAlgorithm
+}
+
+// GetIncludeAttributeNameFormat returns the IncludeAttributeNameFormat field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetIncludeAttributeNameFormat() bool {
+	if s == nil || s.IncludeAttributeNameFormat == nil {
+		return false
+	}
+	return *s.IncludeAttributeNameFormat
+}
+
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetIssuer() string {
+	if s == nil || s.Issuer == nil {
+		return ""
+	}
+	return *s.Issuer
+}
+
+// GetLifetimeInSeconds returns the LifetimeInSeconds field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetLifetimeInSeconds() int {
+	if s == nil || s.LifetimeInSeconds == nil {
+		return 0
+	}
+	return *s.LifetimeInSeconds
+}
+
+// GetLogout returns the Logout field.
+func (s *SAML2ClientAddon) GetLogout() *SAML2ClientAddonLogout {
+	if s == nil {
+		return nil
+	}
+	return s.Logout
+}
+
+// GetMapIdentities returns the MapIdentities field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetMapIdentities() bool {
+	if s == nil || s.MapIdentities == nil {
+		return false
+	}
+	return *s.MapIdentities
+}
+
+// GetMappings returns the Mappings field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetMappings() map[string]string {
+	if s == nil || s.Mappings == nil {
+		return map[string]string{}
+	}
+	return *s.Mappings
+}
+
+// GetMapUnknownClaimsAsIs returns the MapUnknownClaimsAsIs field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetMapUnknownClaimsAsIs() bool {
+	if s == nil || s.MapUnknownClaimsAsIs == nil {
+		return false
+	}
+	return *s.MapUnknownClaimsAsIs
+}
+
+// GetNameIdentifierFormat returns the NameIdentifierFormat field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetNameIdentifierFormat() string {
+	if s == nil || s.NameIdentifierFormat == nil {
+		return ""
+	}
+	return *s.NameIdentifierFormat
+}
+
+// GetNameIdentifierProbes returns the NameIdentifierProbes field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetNameIdentifierProbes() []string {
+	if s == nil || s.NameIdentifierProbes == nil {
+		return nil
+	}
+	return *s.NameIdentifierProbes
+}
+
+// GetPassthroughClaimsWithNoMapping returns the PassthroughClaimsWithNoMapping field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetPassthroughClaimsWithNoMapping() bool {
+	if s == nil || s.PassthroughClaimsWithNoMapping == nil {
+		return false
+	}
+	return *s.PassthroughClaimsWithNoMapping
+}
+
+// GetRecipient returns the Recipient field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetRecipient() string {
+	if s == nil || s.Recipient == nil {
+		return ""
+	}
+	return *s.Recipient
+}
+
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetSignatureAlgorithm() string {
+	if s == nil || s.SignatureAlgorithm == nil {
+		return ""
+	}
+	return *s.SignatureAlgorithm
+}
+
+// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetSigningCert() string {
+	if s == nil || s.SigningCert == nil {
+		return ""
+	}
+	return *s.SigningCert
+}
+
+// GetSignResponse returns the SignResponse field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetSignResponse() bool {
+	if s == nil || s.SignResponse == nil {
+		return false
+	}
+	return *s.SignResponse
+}
+
+// GetTypedAttributes returns the TypedAttributes field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddon) GetTypedAttributes() bool {
+	if s == nil || s.TypedAttributes == nil {
+		return false
+	}
+	return *s.TypedAttributes
+}
+
+// String returns a string representation of SAML2ClientAddon.
+func (s *SAML2ClientAddon) String() string {
+	return Stringify(s)
+}
+
+// GetCallback returns the Callback field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddonLogout) GetCallback() string {
+	if s == nil || s.Callback == nil {
+		return ""
+	}
+	return *s.Callback
+}
+
+// GetSLOEnabled returns the SLOEnabled field if it's non-nil, zero value otherwise.
+func (s *SAML2ClientAddonLogout) GetSLOEnabled() bool {
+	if s == nil || s.SLOEnabled == nil {
+		return false
+	}
+	return *s.SLOEnabled
+}
+
+// String returns a string representation of SAML2ClientAddonLogout.
+func (s *SAML2ClientAddonLogout) String() string {
+	return Stringify(s)
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetClientID() string {
+	if s == nil || s.ClientID == nil {
+		return ""
+	}
+	return *s.ClientID
+}
+
+// GetNameIdentifierFormat returns the NameIdentifierFormat field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetNameIdentifierFormat() string {
+	if s == nil || s.NameIdentifierFormat == nil {
+		return ""
+	}
+	return *s.NameIdentifierFormat
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetScope() string {
+	if s == nil || s.Scope == nil {
+		return ""
+	}
+	return *s.Scope
+}
+
+// GetServicePassword returns the ServicePassword field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetServicePassword() string {
+	if s == nil || s.ServicePassword == nil {
+		return ""
+	}
+	return *s.ServicePassword
+}
+
+// GetTokenEndpointURL returns the TokenEndpointURL field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetTokenEndpointURL() string {
+	if s == nil || s.TokenEndpointURL == nil {
+		return ""
+	}
+	return *s.TokenEndpointURL
+}
+
+// GetUsernameAttribute returns the UsernameAttribute field if it's non-nil, zero value otherwise.
+func (s *SAPAPIClientAddon) GetUsernameAttribute() string {
+	if s == nil || s.UsernameAttribute == nil {
+		return ""
+	}
+	return *s.UsernameAttribute
+}
+
+// String returns a string representation of SAPAPIClientAddon.
+func (s *SAPAPIClientAddon) String() string {
+	return Stringify(s)
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetConnectionID() string {
+	if s == nil || s.ConnectionID == nil {
+		return ""
+	}
+	return *s.ConnectionID
+}
+
+// GetConnectionName returns the ConnectionName field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetConnectionName() string {
+	if s == nil || s.ConnectionName == nil {
+		return ""
+	}
+	return *s.ConnectionName
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetCreatedAt() time.Time {
+	if s == nil || s.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *s.CreatedAt
+}
+
+// GetMapping returns the Mapping field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetMapping() []SCIMConfigurationMapping {
+	if s == nil || s.Mapping == nil {
+		return nil
+	}
+	return *s.Mapping
+}
+
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetStrategy() string {
+	if s == nil || s.Strategy == nil {
+		return ""
+	}
+	return *s.Strategy
+}
+
+// GetTenantName returns the TenantName field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetTenantName() string {
+	if s == nil || s.TenantName == nil {
+		return ""
+	}
+	return *s.TenantName
+}
+
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetUpdatedOn() time.Time {
+	if s == nil || s.UpdatedOn == nil {
+		return time.Time{}
+	}
+	return *s.UpdatedOn
+}
+
+// GetUserIDAttribute returns the UserIDAttribute field if it's non-nil, zero value otherwise.
+func (s *SCIMConfiguration) GetUserIDAttribute() string {
+	if s == nil || s.UserIDAttribute == nil {
+		return ""
+	}
+	return *s.UserIDAttribute
+}
+
+// String returns a string representation of SCIMConfiguration.
+func (s *SCIMConfiguration) String() string {
+	return Stringify(s)
+}
+
+// GetAuth0 returns the Auth0 field if it's non-nil, zero value otherwise.
+func (s *SCIMConfigurationMapping) GetAuth0() string {
+	if s == nil || s.Auth0 == nil {
+		return ""
+	}
+	return *s.Auth0
+}
+
+// GetSCIM returns the SCIM field if it's non-nil, zero value otherwise.
+func (s *SCIMConfigurationMapping) GetSCIM() string {
+	if s == nil || s.SCIM == nil {
+		return ""
+	}
+	return *s.SCIM
+}
+
+// String returns a string representation of SCIMConfigurationMapping.
+func (s *SCIMConfigurationMapping) String() string {
+	return Stringify(s)
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetCreatedAt() time.Time {
+	if s == nil || s.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *s.CreatedAt
+}
+
+// GetLastUsedAt returns the LastUsedAt field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetLastUsedAt() time.Time {
+	if s == nil || s.LastUsedAt == nil {
+		return time.Time{}
+	}
+	return *s.LastUsedAt
+}
+
+// GetScopes returns the Scopes field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetScopes() []string {
+	if s == nil || s.Scopes == nil {
+		return nil
+	}
+	return *s.Scopes
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetToken() string {
+	if s == nil || s.Token == nil {
+		return ""
+	}
+	return *s.Token
+}
+
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetTokenID() string {
+	if s == nil || s.TokenID == nil {
+		return ""
+	}
+	return *s.TokenID
+}
+
+// GetTokenLifeTime returns the TokenLifeTime field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetTokenLifeTime() int {
+	if s == nil || s.TokenLifeTime == nil {
+		return 0
+	}
+	return *s.TokenLifeTime
+}
+
+// GetValidUntil returns the ValidUntil field if it's non-nil, zero value otherwise.
+func (s *SCIMToken) GetValidUntil() time.Time {
+	if s == nil || s.ValidUntil == nil {
+		return time.Time{}
+	}
+	return *s.ValidUntil
+}
+
+// String returns a string representation of SCIMToken.
+func (s *SCIMToken) String() string {
+	return Stringify(s)
+}
+
+// GetAllowedStrategies returns the AllowedStrategies field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetAllowedStrategies() []string {
+	if s == nil || s.AllowedStrategies == nil {
+		return nil
+	}
+	return *s.AllowedStrategies
+}
+
+// GetBranding returns the Branding field.
+func (s *SelfServiceProfile) GetBranding() *SelfServiceProfileBranding {
+	if s == nil {
+		return nil
+	}
+	return s.Branding
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetCreatedAt() time.Time {
+	if s == nil || s.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *s.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfile) GetUpdatedAt() time.Time {
+	if s == nil || s.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *s.UpdatedAt
+}
+
+// String returns a string representation of SelfServiceProfile.
+func (s *SelfServiceProfile) String() string {
+	return Stringify(s)
+}
+
+// GetColors returns the Colors field.
+func (s *SelfServiceProfileBranding) GetColors() *SelfServiceProfileBrandingColors {
+	if s == nil {
+		return nil
+	}
+	return s.Colors
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileBranding) GetLogoURL() string {
+	if s == nil || s.LogoURL == nil {
+		return ""
+	}
+	return *s.LogoURL
+}
+
+// String returns a string representation of SelfServiceProfileBranding.
+func (s *SelfServiceProfileBranding) String() string {
+	return Stringify(s)
+}
+
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileBrandingColors) GetPrimary() string {
+	if s == nil || s.Primary == nil {
+		return ""
+	}
+	return *s.Primary
+}
+
+// String returns a string representation of SelfServiceProfileBrandingColors.
+func (s *SelfServiceProfileBrandingColors) String() string {
+	return Stringify(s)
+}
+
+// String returns a string representation of SelfServiceProfileList.
+func (s *SelfServiceProfileList) String() string {
+	return Stringify(s)
+}
+
+// GetConnectionConfig returns the ConnectionConfig field.
+func (s *SelfServiceProfileTicket) GetConnectionConfig() *SelfServiceProfileTicketConnectionConfig {
+	if s == nil {
+		return nil
+	}
+	return s.ConnectionConfig
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicket) GetConnectionID() string {
+	if s == nil || s.ConnectionID == nil {
+		return ""
+	}
+	return *s.ConnectionID
+}
+
+// GetDomainAliasesConfig returns the DomainAliasesConfig field.
+func (s *SelfServiceProfileTicket) GetDomainAliasesConfig() *SelfServiceProfileTicketDomainAliasesConfig {
+	if s == nil {
+		return nil
+	}
+	return s.DomainAliasesConfig
+}
+
+// GetTicket returns the Ticket field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicket) GetTicket() string {
+	if s == nil || s.Ticket == nil {
+		return ""
+	}
+	return *s.Ticket
+}
+
+// GetTTLSec returns the TTLSec field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicket) GetTTLSec() int {
+	if s == nil || s.TTLSec == nil {
+		return 0
+	}
+	return *s.TTLSec
+}
+
+// String returns a string representation of SelfServiceProfileTicket.
+func (s *SelfServiceProfileTicket) String() string {
+	return Stringify(s)
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionConfig) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetIsDomainConnection returns the IsDomainConnection field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionConfig) GetIsDomainConnection() bool {
+	if s == nil || s.IsDomainConnection == nil {
+		return false
+	}
+	return *s.IsDomainConnection
+}
+
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionConfig) GetMetadata() map[string]string {
+	if s == nil || s.Metadata == nil {
+		return map[string]string{}
+	}
+	return *s.Metadata
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionConfig) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetOptions returns the Options field.
+func (s *SelfServiceProfileTicketConnectionConfig) GetOptions() *SelfServiceProfileTicketConnectionOptions {
+	if s == nil {
+		return nil
+	}
+	return s.Options
+}
+
+// GetShowAsButton returns the ShowAsButton field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionConfig) GetShowAsButton() bool {
+	if s == nil || s.ShowAsButton == nil {
+		return false
+	}
+	return *s.ShowAsButton
 }
 
-// GetCommunityURLSection returns the CommunityURLSection field if it's non-nil, zero value otherwise.
-func (s *SalesforceSandboxAPIClientAddon) GetCommunityURLSection() string {
-	if s == nil || s.CommunityURLSection == nil {
-		return ""
+// String returns a string representation of SelfServiceProfileTicketConnectionConfig.
+func (s *SelfServiceProfileTicketConnectionConfig) String() string {
+	return Stringify(s)
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionOptions) GetDomainAliases() []string {
+	if s == nil || s.DomainAliases == nil {
+		return nil
 	}
-	return *s.CommunityURLSection
+	return *s.DomainAliases
 }
 
-// GetPrincipal returns the Principal field if it's non-nil, zero value otherwise.
-func (s *SalesforceSandboxAPIClientAddon) GetPrincipal() string {
-	if s == nil || s.Principal == nil {
+// GetIconURL returns the IconURL field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketConnectionOptions) GetIconURL() string {
+	if s == nil || s.IconURL == nil {
 		return ""
 	}
-	return *s.Principal
+	return *s.IconURL
 }
 
-// String returns a string representation of SalesforceSandboxAPIClientAddon.
-func (s *SalesforceSandboxAPIClientAddon) String() string {
+// String returns a string representation of SelfServiceProfileTicketConnectionOptions.
+func (s *SelfServiceProfileTicketConnectionOptions) String() string {
 	return Stringify(s)
 }
 
-// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetAudience() string {
-	if s == nil || s.Audience == nil {
+// GetDomainVerification returns the DomainVerification field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketDomainAliasesConfig) GetDomainVerification() string {
+	if s == nil || s.DomainVerification == nil {
 		return ""
 	}
-	return *s.Audience
+	return *s.DomainVerification
 }
 
-// GetAuthnContextClassRef returns the AuthnContextClassRef field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetAuthnContextClassRef() string {
-	if s == nil || s.AuthnContextClassRef == nil {
-		return ""
+// String returns a string representation of SelfServiceProfileTicketDomainAliasesConfig.
+func (s *SelfServiceProfileTicketDomainAliasesConfig) String() string {
+	return Stringify(s)
+}
+
+// GetAssignMembershipOnLogin returns the AssignMembershipOnLogin field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketEnabledOrganization) GetAssignMembershipOnLogin() bool {
+	if s == nil || s.AssignMembershipOnLogin == nil {
+		return false
 	}
-	return *s.AuthnContextClassRef
+	return *s.AssignMembershipOnLogin
 }
 
-// GetBinding returns the Binding field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetBinding() string {
-	if s == nil || s.Binding == nil {
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketEnabledOrganization) GetOrganizationID() string {
+	if s == nil || s.OrganizationID == nil {
 		return ""
 	}
-	return *s.Binding
+	return *s.OrganizationID
 }
 
-// GetCreateUPNClaim returns the CreateUPNClaim field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetCreateUPNClaim() bool {
-	if s == nil || s.CreateUPNClaim == nil {
+// GetShowAsButton returns the ShowAsButton field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileTicketEnabledOrganization) GetShowAsButton() bool {
+	if s == nil || s.ShowAsButton == nil {
 		return false
 	}
-	return *s.CreateUPNClaim
+	return *s.ShowAsButton
 }
 
-// GetDestination returns the Destination field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetDestination() string {
-	if s == nil || s.Destination == nil {
-		return ""
-	}
-	return *s.Destination
+// String returns a string representation of SelfServiceProfileTicketEnabledOrganization.
+func (s *SelfServiceProfileTicketEnabledOrganization) String() string {
+	return Stringify(s)
 }
 
-// GetDigestAlgorithm returns the DigestAlgorithm field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetDigestAlgorithm() string {
-	if s == nil || s.DigestAlgorithm == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileUserAttribute) GetDescription() string {
+	if s == nil || s.Description == nil {
 		return ""
 	}
-	return *s.DigestAlgorithm
+	return *s.Description
 }
 
-// GetIncludeAttributeNameFormat returns the IncludeAttributeNameFormat field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetIncludeAttributeNameFormat() bool {
-	if s == nil || s.IncludeAttributeNameFormat == nil {
+// GetIsOptional returns the IsOptional field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileUserAttribute) GetIsOptional() bool {
+	if s == nil || s.IsOptional == nil {
 		return false
 	}
-	return *s.IncludeAttributeNameFormat
+	return *s.IsOptional
 }
 
-// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetIssuer() string {
-	if s == nil || s.Issuer == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SelfServiceProfileUserAttribute) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *s.Issuer
+	return *s.Name
 }
 
-// GetLifetimeInSeconds returns the LifetimeInSeconds field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetLifetimeInSeconds() int {
-	if s == nil || s.LifetimeInSeconds == nil {
-		return 0
-	}
-	return *s.LifetimeInSeconds
+// String returns a string representation of SelfServiceProfileUserAttribute.
+func (s *SelfServiceProfileUserAttribute) String() string {
+	return Stringify(s)
 }
 
-// GetLogout returns the Logout field.
-func (s *SAML2ClientAddon) GetLogout() *SAML2ClientAddonLogout {
-	if s == nil {
-		return nil
+// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
+func (s *SentryClientAddon) GetBaseURL() string {
+	if s == nil || s.BaseURL == nil {
+		return ""
 	}
-	return s.Logout
+	return *s.BaseURL
 }
 
-// GetMapIdentities returns the MapIdentities field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetMapIdentities() bool {
-	if s == nil || s.MapIdentities == nil {
-		return false
+// GetOrgSlug returns the OrgSlug field if it's non-nil, zero value otherwise.
+func (s *SentryClientAddon) GetOrgSlug() string {
+	if s == nil || s.OrgSlug == nil {
+		return ""
 	}
-	return *s.MapIdentities
+	return *s.OrgSlug
 }
 
-// GetMappings returns the Mappings field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetMappings() map[string]string {
-	if s == nil || s.Mappings == nil {
-		return map[string]string{}
+// String returns a string representation of SentryClientAddon.
+func (s *SentryClientAddon) String() string {
+	return Stringify(s)
+}
+
+// GetAuthenticatedAt returns the AuthenticatedAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetAuthenticatedAt() time.Time {
+	if s == nil || s.AuthenticatedAt == nil {
+		return time.Time{}
 	}
-	return *s.Mappings
+	return *s.AuthenticatedAt
 }
 
-// GetMapUnknownClaimsAsIs returns the MapUnknownClaimsAsIs field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetMapUnknownClaimsAsIs() bool {
-	if s == nil || s.MapUnknownClaimsAsIs == nil {
-		return false
+// GetAuthentication returns the Authentication field.
+func (s *Session) GetAuthentication() *SessionAuthentication {
+	if s == nil {
+		return nil
 	}
-	return *s.MapUnknownClaimsAsIs
+	return s.Authentication
 }
 
-// GetNameIdentifierFormat returns the NameIdentifierFormat field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetNameIdentifierFormat() string {
-	if s == nil || s.NameIdentifierFormat == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetCreatedAt() time.Time {
+	if s == nil || s.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *s.NameIdentifierFormat
+	return *s.CreatedAt
 }
 
-// GetNameIdentifierProbes returns the NameIdentifierProbes field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetNameIdentifierProbes() []string {
-	if s == nil || s.NameIdentifierProbes == nil {
+// GetDevice returns the Device field.
+func (s *Session) GetDevice() *SessionDevice {
+	if s == nil {
 		return nil
 	}
-	return *s.NameIdentifierProbes
+	return s.Device
 }
 
-// GetPassthroughClaimsWithNoMapping returns the PassthroughClaimsWithNoMapping field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetPassthroughClaimsWithNoMapping() bool {
-	if s == nil || s.PassthroughClaimsWithNoMapping == nil {
-		return false
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetExpiresAt() time.Time {
+	if s == nil || s.ExpiresAt == nil {
+		return time.Time{}
 	}
-	return *s.PassthroughClaimsWithNoMapping
+	return *s.ExpiresAt
 }
 
-// GetRecipient returns the Recipient field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetRecipient() string {
-	if s == nil || s.Recipient == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *Session) GetID() string {
+	if s == nil || s.ID == nil {
 		return ""
 	}
-	return *s.Recipient
+	return *s.ID
 }
 
-// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetSignatureAlgorithm() string {
-	if s == nil || s.SignatureAlgorithm == nil {
-		return ""
+// GetIdleExpiresAt returns the IdleExpiresAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetIdleExpiresAt() time.Time {
+	if s == nil || s.IdleExpiresAt == nil {
+		return time.Time{}
 	}
-	return *s.SignatureAlgorithm
+	return *s.IdleExpiresAt
 }
 
-// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetSigningCert() string {
-	if s == nil || s.SigningCert == nil {
-		return ""
+// GetLastInteractedAt returns the LastInteractedAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetLastInteractedAt() time.Time {
+	if s == nil || s.LastInteractedAt == nil {
+		return time.Time{}
 	}
-	return *s.SigningCert
+	return *s.LastInteractedAt
 }
 
-// GetSignResponse returns the SignResponse field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetSignResponse() bool {
-	if s == nil || s.SignResponse == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *Session) GetUpdatedAt() time.Time {
+	if s == nil || s.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *s.SignResponse
+	return *s.UpdatedAt
 }
 
-// GetTypedAttributes returns the TypedAttributes field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddon) GetTypedAttributes() bool {
-	if s == nil || s.TypedAttributes == nil {
-		return false
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (s *Session) GetUserID() string {
+	if s == nil || s.UserID == nil {
+		return ""
 	}
-	return *s.TypedAttributes
+	return *s.UserID
 }
 
-// String returns a string representation of SAML2ClientAddon.
-func (s *SAML2ClientAddon) String() string {
+// String returns a string representation of Session.
+func (s *Session) String() string {
 	return Stringify(s)
 }
 
-// GetCallback returns the Callback field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddonLogout) GetCallback() string {
-	if s == nil || s.Callback == nil {
+// String returns a string representation of SessionAuthentication.
+func (s *SessionAuthentication) String() string {
+	return Stringify(s)
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SessionAuthenticationMethod) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *s.Callback
+	return *s.Name
 }
 
-// GetSLOEnabled returns the SLOEnabled field if it's non-nil, zero value otherwise.
-func (s *SAML2ClientAddonLogout) GetSLOEnabled() bool {
-	if s == nil || s.SLOEnabled == nil {
-		return false
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (s *SessionAuthenticationMethod) GetTimestamp() time.Time {
+	if s == nil || s.Timestamp == nil {
+		return time.Time{}
 	}
-	return *s.SLOEnabled
+	return *s.Timestamp
 }
 
-// String returns a string representation of SAML2ClientAddonLogout.
-func (s *SAML2ClientAddonLogout) String() string {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SessionAuthenticationMethod) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// String returns a string representation of SessionAuthenticationMethod.
+func (s *SessionAuthenticationMethod) String() string {
 	return Stringify(s)
 }
 
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetClientID() string {
+func (s *SessionClient) GetClientID() string {
 	if s == nil || s.ClientID == nil {
 		return ""
 	}
 	return *s.ClientID
 }
 
-// GetNameIdentifierFormat returns the NameIdentifierFormat field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetNameIdentifierFormat() string {
-	if s == nil || s.NameIdentifierFormat == nil {
-		return ""
-	}
-	return *s.NameIdentifierFormat
+// String returns a string representation of SessionClient.
+func (s *SessionClient) String() string {
+	return Stringify(s)
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetScope() string {
-	if s == nil || s.Scope == nil {
+// GetInitialASN returns the InitialASN field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetInitialASN() string {
+	if s == nil || s.InitialASN == nil {
 		return ""
 	}
-	return *s.Scope
+	return *s.InitialASN
 }
 
-// GetServicePassword returns the ServicePassword field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetServicePassword() string {
-	if s == nil || s.ServicePassword == nil {
+// GetInitialIP returns the InitialIP field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetInitialIP() string {
+	if s == nil || s.InitialIP == nil {
 		return ""
 	}
-	return *s.ServicePassword
+	return *s.InitialIP
 }
 
-// GetTokenEndpointURL returns the TokenEndpointURL field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetTokenEndpointURL() string {
-	if s == nil || s.TokenEndpointURL == nil {
+// GetInitialUserAgent returns the InitialUserAgent field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetInitialUserAgent() string {
+	if s == nil || s.InitialUserAgent == nil {
 		return ""
 	}
-	return *s.TokenEndpointURL
+	return *s.InitialUserAgent
 }
 
-// GetUsernameAttribute returns the UsernameAttribute field if it's non-nil, zero value otherwise.
-func (s *SAPAPIClientAddon) GetUsernameAttribute() string {
-	if s == nil || s.UsernameAttribute == nil {
+// GetLastASN returns the LastASN field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetLastASN() string {
+	if s == nil || s.LastASN == nil {
 		return ""
 	}
-	return *s.UsernameAttribute
-}
-
-// String returns a string representation of SAPAPIClientAddon.
-func (s *SAPAPIClientAddon) String() string {
-	return Stringify(s)
+	return *s.LastASN
 }
 
-// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
-func (s *SentryClientAddon) GetBaseURL() string {
-	if s == nil || s.BaseURL == nil {
+// GetLastIP returns the LastIP field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetLastIP() string {
+	if s == nil || s.LastIP == nil {
 		return ""
 	}
-	return *s.BaseURL
+	return *s.LastIP
 }
 
-// GetOrgSlug returns the OrgSlug field if it's non-nil, zero value otherwise.
-func (s *SentryClientAddon) GetOrgSlug() string {
-	if s == nil || s.OrgSlug == nil {
+// GetLastUserAgent returns the LastUserAgent field if it's non-nil, zero value otherwise.
+func (s *SessionDevice) GetLastUserAgent() string {
+	if s == nil || s.LastUserAgent == nil {
 		return ""
 	}
-	return *s.OrgSlug
+	return *s.LastUserAgent
 }
 
-// String returns a string representation of SentryClientAddon.
-func (s *SentryClientAddon) String() string {
+// String returns a string representation of SessionDevice.
+func (s *SessionDevice) String() string {
+	return Stringify(s)
+}
+
+// String returns a string representation of SessionList.
+func (s *SessionList) String() string {
 	return Stringify(s)
 }
 
@@ -9893,6 +11419,56 @@ func (u *User) String() string {
 	return Stringify(u)
 }
 
+// GetBlock returns the Block field.
+func (u *UserActivity) GetBlock() *UserBlock {
+	if u == nil {
+		return nil
+	}
+	return u.Block
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (u *UserActivity) GetDate() time.Time {
+	if u == nil || u.Date == nil {
+		return time.Time{}
+	}
+	return *u.Date
+}
+
+// GetEnrollment returns the Enrollment field.
+func (u *UserActivity) GetEnrollment() *UserEnrollment {
+	if u == nil {
+		return nil
+	}
+	return u.Enrollment
+}
+
+// GetLog returns the Log field.
+func (u *UserActivity) GetLog() *Log {
+	if u == nil {
+		return nil
+	}
+	return u.Log
+}
+
+// GetOrganization returns the Organization field.
+func (u *UserActivity) GetOrganization() *Organization {
+	if u == nil {
+		return nil
+	}
+	return u.Organization
+}
+
+// String returns a string representation of UserActivity.
+func (u *UserActivity) String() string {
+	return Stringify(u)
+}
+
+// String returns a string representation of UserActivityTimeline.
+func (u *UserActivityTimeline) String() string {
+	return Stringify(u)
+}
+
 // GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
 func (u *UserBlock) GetIdentifier() string {
 	if u == nil || u.Identifier == nil {
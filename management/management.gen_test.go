@@ -232,6 +232,14 @@ func TestActionBindingReference_String(t *testing.T) {
 	}
 }
 
+func TestActionBuildError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionBuildError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionDependency_GetName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionDependency{Name: &zeroValue}
@@ -328,6 +336,114 @@ func TestActionExecution_String(t *testing.T) {
 	}
 }
 
+func TestActionExecutionError_GetID(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionError{ID: &zeroValue}
+	a.GetID()
+	a = &ActionExecutionError{}
+	a.GetID()
+	a = nil
+	a.GetID()
+}
+
+func TestActionExecutionError_GetMessage(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionError{Message: &zeroValue}
+	a.GetMessage()
+	a = &ActionExecutionError{}
+	a.GetMessage()
+	a = nil
+	a.GetMessage()
+}
+
+func TestActionExecutionError_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionError{Name: &zeroValue}
+	a.GetName()
+	a = &ActionExecutionError{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestActionExecutionError_GetStack(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionError{Stack: &zeroValue}
+	a.GetStack()
+	a = &ActionExecutionError{}
+	a.GetStack()
+	a = nil
+	a.GetStack()
+}
+
+func TestActionExecutionError_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionError{URL: &zeroValue}
+	a.GetURL()
+	a = &ActionExecutionError{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
+func TestActionExecutionError_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionExecutionError{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestActionExecutionReport_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionExecutionReport{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestActionExecutionResponse_GetData(tt *testing.T) {
+	zeroValue := map[string]interface{}{}
+	a := &ActionExecutionResponse{Data: zeroValue}
+	a.GetData()
+	a = &ActionExecutionResponse{}
+	a.GetData()
+	a = nil
+	a.GetData()
+}
+
+func TestActionExecutionResponse_GetError(tt *testing.T) {
+	a := &ActionExecutionResponse{}
+	a.GetError()
+	a = nil
+	a.GetError()
+}
+
+func TestActionExecutionResponse_GetLogs(tt *testing.T) {
+	var zeroValue string
+	a := &ActionExecutionResponse{Logs: &zeroValue}
+	a.GetLogs()
+	a = &ActionExecutionResponse{}
+	a.GetLogs()
+	a = nil
+	a.GetLogs()
+}
+
+func TestActionExecutionResponse_GetStats(tt *testing.T) {
+	a := &ActionExecutionResponse{}
+	a.GetStats()
+	a = nil
+	a.GetStats()
+}
+
+func TestActionExecutionResponse_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionExecutionResponse{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionExecutionResult_GetActionName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionExecutionResult{ActionName: &zeroValue}
@@ -358,6 +474,13 @@ func TestActionExecutionResult_GetError(tt *testing.T) {
 	a.GetError()
 }
 
+func TestActionExecutionResult_GetResponse(tt *testing.T) {
+	a := &ActionExecutionResult{}
+	a.GetResponse()
+	a = nil
+	a.GetResponse()
+}
+
 func TestActionExecutionResult_GetStartedAt(tt *testing.T) {
 	var zeroValue time.Time
 	a := &ActionExecutionResult{StartedAt: &zeroValue}
@@ -376,6 +499,62 @@ func TestActionExecutionResult_String(t *testing.T) {
 	}
 }
 
+func TestActionExecutionStats_GetActionDuration(tt *testing.T) {
+	var zeroValue int
+	a := &ActionExecutionStats{ActionDuration: &zeroValue}
+	a.GetActionDuration()
+	a = &ActionExecutionStats{}
+	a.GetActionDuration()
+	a = nil
+	a.GetActionDuration()
+}
+
+func TestActionExecutionStats_GetBootDuration(tt *testing.T) {
+	var zeroValue int
+	a := &ActionExecutionStats{BootDuration: &zeroValue}
+	a.GetBootDuration()
+	a = &ActionExecutionStats{}
+	a.GetBootDuration()
+	a = nil
+	a.GetBootDuration()
+}
+
+func TestActionExecutionStats_GetTotalRequestDuration(tt *testing.T) {
+	var zeroValue int
+	a := &ActionExecutionStats{TotalRequestDuration: &zeroValue}
+	a.GetTotalRequestDuration()
+	a = &ActionExecutionStats{}
+	a.GetTotalRequestDuration()
+	a = nil
+	a.GetTotalRequestDuration()
+}
+
+func TestActionExecutionStats_GetTotalRuntimeExecutionDuration(tt *testing.T) {
+	var zeroValue int
+	a := &ActionExecutionStats{TotalRuntimeExecutionDuration: &zeroValue}
+	a.GetTotalRuntimeExecutionDuration()
+	a = &ActionExecutionStats{}
+	a.GetTotalRuntimeExecutionDuration()
+	a = nil
+	a.GetTotalRuntimeExecutionDuration()
+}
+
+func TestActionExecutionStats_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionExecutionStats{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestActionExecutionSummary_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionExecutionSummary{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionList{}
@@ -384,6 +563,84 @@ func TestActionList_String(t *testing.T) {
 	}
 }
 
+func TestActionLogLine_GetActionID(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{ActionID: &zeroValue}
+	a.GetActionID()
+	a = &ActionLogLine{}
+	a.GetActionID()
+	a = nil
+	a.GetActionID()
+}
+
+func TestActionLogLine_GetActionName(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{ActionName: &zeroValue}
+	a.GetActionName()
+	a = &ActionLogLine{}
+	a.GetActionName()
+	a = nil
+	a.GetActionName()
+}
+
+func TestActionLogLine_GetExecutionID(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{ExecutionID: &zeroValue}
+	a.GetExecutionID()
+	a = &ActionLogLine{}
+	a.GetExecutionID()
+	a = nil
+	a.GetExecutionID()
+}
+
+func TestActionLogLine_GetLevel(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{Level: &zeroValue}
+	a.GetLevel()
+	a = &ActionLogLine{}
+	a.GetLevel()
+	a = nil
+	a.GetLevel()
+}
+
+func TestActionLogLine_GetMessage(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{Message: &zeroValue}
+	a.GetMessage()
+	a = &ActionLogLine{}
+	a.GetMessage()
+	a = nil
+	a.GetMessage()
+}
+
+func TestActionLogLine_GetTimestamp(tt *testing.T) {
+	var zeroValue time.Time
+	a := &ActionLogLine{Timestamp: &zeroValue}
+	a.GetTimestamp()
+	a = &ActionLogLine{}
+	a.GetTimestamp()
+	a = nil
+	a.GetTimestamp()
+}
+
+func TestActionLogLine_GetTriggerID(tt *testing.T) {
+	var zeroValue string
+	a := &ActionLogLine{TriggerID: &zeroValue}
+	a.GetTriggerID()
+	a = &ActionLogLine{}
+	a.GetTriggerID()
+	a = nil
+	a.GetTriggerID()
+}
+
+func TestActionLogLine_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionLogLine{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionLogSession_GetExpires(tt *testing.T) {
 	var zeroValue time.Time
 	a := &ActionLogSession{Expires: &zeroValue}
@@ -420,6 +677,40 @@ func TestActionLogSessionFilter_String(t *testing.T) {
 	}
 }
 
+func TestActionLogStream_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionLogStream{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestActionLogTailOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionLogTailOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestActionPublishOptions_GetPosition(tt *testing.T) {
+	var zeroValue int
+	a := &ActionPublishOptions{Position: &zeroValue}
+	a.GetPosition()
+	a = &ActionPublishOptions{}
+	a.GetPosition()
+	a = nil
+	a.GetPosition()
+}
+
+func TestActionPublishOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ActionPublishOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestActionSecret_GetName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionSecret{Name: &zeroValue}
@@ -1284,118 +1575,478 @@ func TestBrandingPageBackgroundGradient_String(t *testing.T) {
 	}
 }
 
-func TestBrandingTheme_GetDisplayName(tt *testing.T) {
+func TestBrandingPhoneNotificationTemplate_GetChannel(tt *testing.T) {
 	var zeroValue string
-	b := &BrandingTheme{DisplayName: &zeroValue}
-	b.GetDisplayName()
-	b = &BrandingTheme{}
-	b.GetDisplayName()
+	b := &BrandingPhoneNotificationTemplate{Channel: &zeroValue}
+	b.GetChannel()
+	b = &BrandingPhoneNotificationTemplate{}
+	b.GetChannel()
 	b = nil
-	b.GetDisplayName()
+	b.GetChannel()
 }
 
-func TestBrandingTheme_GetID(tt *testing.T) {
+func TestBrandingPhoneNotificationTemplate_GetContent(tt *testing.T) {
+	b := &BrandingPhoneNotificationTemplate{}
+	b.GetContent()
+	b = nil
+	b.GetContent()
+}
+
+func TestBrandingPhoneNotificationTemplate_GetCustomizable(tt *testing.T) {
+	var zeroValue bool
+	b := &BrandingPhoneNotificationTemplate{Customizable: &zeroValue}
+	b.GetCustomizable()
+	b = &BrandingPhoneNotificationTemplate{}
+	b.GetCustomizable()
+	b = nil
+	b.GetCustomizable()
+}
+
+func TestBrandingPhoneNotificationTemplate_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	b := &BrandingPhoneNotificationTemplate{Disabled: &zeroValue}
+	b.GetDisabled()
+	b = &BrandingPhoneNotificationTemplate{}
+	b.GetDisabled()
+	b = nil
+	b.GetDisabled()
+}
+
+func TestBrandingPhoneNotificationTemplate_GetID(tt *testing.T) {
 	var zeroValue string
-	b := &BrandingTheme{ID: &zeroValue}
+	b := &BrandingPhoneNotificationTemplate{ID: &zeroValue}
 	b.GetID()
-	b = &BrandingTheme{}
+	b = &BrandingPhoneNotificationTemplate{}
 	b.GetID()
 	b = nil
 	b.GetID()
 }
 
-func TestBrandingTheme_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &BrandingTheme{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestBrandingPhoneNotificationTemplate_GetTenant(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneNotificationTemplate{Tenant: &zeroValue}
+	b.GetTenant()
+	b = &BrandingPhoneNotificationTemplate{}
+	b.GetTenant()
+	b = nil
+	b.GetTenant()
 }
 
-func TestBrandingThemeBorders_String(t *testing.T) {
+func TestBrandingPhoneNotificationTemplate_GetType(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneNotificationTemplate{Type: &zeroValue}
+	b.GetType()
+	b = &BrandingPhoneNotificationTemplate{}
+	b.GetType()
+	b = nil
+	b.GetType()
+}
+
+func TestBrandingPhoneNotificationTemplate_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &BrandingThemeBorders{}
+	v := &BrandingPhoneNotificationTemplate{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestBrandingThemeColors_GetBaseFocusColor(tt *testing.T) {
+func TestBrandingPhoneNotificationTemplateBody_GetText(tt *testing.T) {
 	var zeroValue string
-	b := &BrandingThemeColors{BaseFocusColor: &zeroValue}
-	b.GetBaseFocusColor()
-	b = &BrandingThemeColors{}
-	b.GetBaseFocusColor()
+	b := &BrandingPhoneNotificationTemplateBody{Text: &zeroValue}
+	b.GetText()
+	b = &BrandingPhoneNotificationTemplateBody{}
+	b.GetText()
 	b = nil
-	b.GetBaseFocusColor()
+	b.GetText()
 }
 
-func TestBrandingThemeColors_GetBaseHoverColor(tt *testing.T) {
+func TestBrandingPhoneNotificationTemplateBody_GetVoice(tt *testing.T) {
 	var zeroValue string
-	b := &BrandingThemeColors{BaseHoverColor: &zeroValue}
-	b.GetBaseHoverColor()
-	b = &BrandingThemeColors{}
-	b.GetBaseHoverColor()
+	b := &BrandingPhoneNotificationTemplateBody{Voice: &zeroValue}
+	b.GetVoice()
+	b = &BrandingPhoneNotificationTemplateBody{}
+	b.GetVoice()
 	b = nil
-	b.GetBaseHoverColor()
+	b.GetVoice()
 }
 
-func TestBrandingThemeColors_String(t *testing.T) {
+func TestBrandingPhoneNotificationTemplateBody_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &BrandingThemeColors{}
+	v := &BrandingPhoneNotificationTemplateBody{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestBrandingThemeFonts_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &BrandingThemeFonts{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestBrandingPhoneNotificationTemplateContent_GetBody(tt *testing.T) {
+	b := &BrandingPhoneNotificationTemplateContent{}
+	b.GetBody()
+	b = nil
+	b.GetBody()
 }
 
-func TestBrandingThemePageBackground_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &BrandingThemePageBackground{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestBrandingPhoneNotificationTemplateContent_GetFrom(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneNotificationTemplateContent{From: &zeroValue}
+	b.GetFrom()
+	b = &BrandingPhoneNotificationTemplateContent{}
+	b.GetFrom()
+	b = nil
+	b.GetFrom()
 }
 
-func TestBrandingThemeText_String(t *testing.T) {
+func TestBrandingPhoneNotificationTemplateContent_GetSyntax(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneNotificationTemplateContent{Syntax: &zeroValue}
+	b.GetSyntax()
+	b = &BrandingPhoneNotificationTemplateContent{}
+	b.GetSyntax()
+	b = nil
+	b.GetSyntax()
+}
+
+func TestBrandingPhoneNotificationTemplateContent_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &BrandingThemeText{}
+	v := &BrandingPhoneNotificationTemplateContent{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestBrandingThemeWidget_String(t *testing.T) {
+func TestBrandingPhoneNotificationTemplateList_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &BrandingThemeWidget{}
+	v := &BrandingPhoneNotificationTemplateList{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestBrandingUniversalLogin_GetBody(tt *testing.T) {
+func TestBrandingPhoneProvider_GetChannel(tt *testing.T) {
 	var zeroValue string
-	b := &BrandingUniversalLogin{Body: &zeroValue}
-	b.GetBody()
-	b = &BrandingUniversalLogin{}
-	b.GetBody()
+	b := &BrandingPhoneProvider{Channel: &zeroValue}
+	b.GetChannel()
+	b = &BrandingPhoneProvider{}
+	b.GetChannel()
 	b = nil
-	b.GetBody()
+	b.GetChannel()
 }
 
-func TestBrandingUniversalLogin_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &BrandingUniversalLogin{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestBrandingPhoneProvider_GetConfiguration(tt *testing.T) {
+	b := &BrandingPhoneProvider{}
+	b.GetConfiguration()
+	b = nil
+	b.GetConfiguration()
+}
+
+func TestBrandingPhoneProvider_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	b := &BrandingPhoneProvider{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BrandingPhoneProvider{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
+}
+
+func TestBrandingPhoneProvider_GetCredentials(tt *testing.T) {
+	b := &BrandingPhoneProvider{}
+	b.GetCredentials()
+	b = nil
+	b.GetCredentials()
+}
+
+func TestBrandingPhoneProvider_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	b := &BrandingPhoneProvider{Disabled: &zeroValue}
+	b.GetDisabled()
+	b = &BrandingPhoneProvider{}
+	b.GetDisabled()
+	b = nil
+	b.GetDisabled()
+}
+
+func TestBrandingPhoneProvider_GetID(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProvider{ID: &zeroValue}
+	b.GetID()
+	b = &BrandingPhoneProvider{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBrandingPhoneProvider_GetName(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProvider{Name: &zeroValue}
+	b.GetName()
+	b = &BrandingPhoneProvider{}
+	b.GetName()
+	b = nil
+	b.GetName()
+}
+
+func TestBrandingPhoneProvider_GetTenant(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProvider{Tenant: &zeroValue}
+	b.GetTenant()
+	b = &BrandingPhoneProvider{}
+	b.GetTenant()
+	b = nil
+	b.GetTenant()
+}
+
+func TestBrandingPhoneProvider_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	b := &BrandingPhoneProvider{UpdatedAt: &zeroValue}
+	b.GetUpdatedAt()
+	b = &BrandingPhoneProvider{}
+	b.GetUpdatedAt()
+	b = nil
+	b.GetUpdatedAt()
+}
+
+func TestBrandingPhoneProvider_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingPhoneProvider{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingPhoneProviderConfiguration_GetDefaultFrom(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProviderConfiguration{DefaultFrom: &zeroValue}
+	b.GetDefaultFrom()
+	b = &BrandingPhoneProviderConfiguration{}
+	b.GetDefaultFrom()
+	b = nil
+	b.GetDefaultFrom()
+}
+
+func TestBrandingPhoneProviderConfiguration_GetDeliveryMethods(tt *testing.T) {
+	var zeroValue []string
+	b := &BrandingPhoneProviderConfiguration{DeliveryMethods: &zeroValue}
+	b.GetDeliveryMethods()
+	b = &BrandingPhoneProviderConfiguration{}
+	b.GetDeliveryMethods()
+	b = nil
+	b.GetDeliveryMethods()
+}
+
+func TestBrandingPhoneProviderConfiguration_GetMSSID(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProviderConfiguration{MSSID: &zeroValue}
+	b.GetMSSID()
+	b = &BrandingPhoneProviderConfiguration{}
+	b.GetMSSID()
+	b = nil
+	b.GetMSSID()
+}
+
+func TestBrandingPhoneProviderConfiguration_GetSID(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProviderConfiguration{SID: &zeroValue}
+	b.GetSID()
+	b = &BrandingPhoneProviderConfiguration{}
+	b.GetSID()
+	b = nil
+	b.GetSID()
+}
+
+func TestBrandingPhoneProviderConfiguration_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingPhoneProviderConfiguration{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingPhoneProviderCredentials_GetAuthToken(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneProviderCredentials{AuthToken: &zeroValue}
+	b.GetAuthToken()
+	b = &BrandingPhoneProviderCredentials{}
+	b.GetAuthToken()
+	b = nil
+	b.GetAuthToken()
+}
+
+func TestBrandingPhoneProviderCredentials_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingPhoneProviderCredentials{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingPhoneProviderList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingPhoneProviderList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingPhoneTestNotification_GetCode(tt *testing.T) {
+	var zeroValue int
+	b := &BrandingPhoneTestNotification{Code: &zeroValue}
+	b.GetCode()
+	b = &BrandingPhoneTestNotification{}
+	b.GetCode()
+	b = nil
+	b.GetCode()
+}
+
+func TestBrandingPhoneTestNotification_GetDeliveryMethod(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneTestNotification{DeliveryMethod: &zeroValue}
+	b.GetDeliveryMethod()
+	b = &BrandingPhoneTestNotification{}
+	b.GetDeliveryMethod()
+	b = nil
+	b.GetDeliveryMethod()
+}
+
+func TestBrandingPhoneTestNotification_GetMessage(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneTestNotification{Message: &zeroValue}
+	b.GetMessage()
+	b = &BrandingPhoneTestNotification{}
+	b.GetMessage()
+	b = nil
+	b.GetMessage()
+}
+
+func TestBrandingPhoneTestNotification_GetTo(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingPhoneTestNotification{To: &zeroValue}
+	b.GetTo()
+	b = &BrandingPhoneTestNotification{}
+	b.GetTo()
+	b = nil
+	b.GetTo()
+}
+
+func TestBrandingPhoneTestNotification_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingPhoneTestNotification{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingTheme_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingTheme{DisplayName: &zeroValue}
+	b.GetDisplayName()
+	b = &BrandingTheme{}
+	b.GetDisplayName()
+	b = nil
+	b.GetDisplayName()
+}
+
+func TestBrandingTheme_GetID(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingTheme{ID: &zeroValue}
+	b.GetID()
+	b = &BrandingTheme{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBrandingTheme_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingTheme{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemeBorders_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemeBorders{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemeColors_GetBaseFocusColor(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingThemeColors{BaseFocusColor: &zeroValue}
+	b.GetBaseFocusColor()
+	b = &BrandingThemeColors{}
+	b.GetBaseFocusColor()
+	b = nil
+	b.GetBaseFocusColor()
+}
+
+func TestBrandingThemeColors_GetBaseHoverColor(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingThemeColors{BaseHoverColor: &zeroValue}
+	b.GetBaseHoverColor()
+	b = &BrandingThemeColors{}
+	b.GetBaseHoverColor()
+	b = nil
+	b.GetBaseHoverColor()
+}
+
+func TestBrandingThemeColors_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemeColors{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemeFonts_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemeFonts{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemePageBackground_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemePageBackground{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemeText_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemeText{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingThemeWidget_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingThemeWidget{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestBrandingUniversalLogin_GetBody(tt *testing.T) {
+	var zeroValue string
+	b := &BrandingUniversalLogin{Body: &zeroValue}
+	b.GetBody()
+	b = &BrandingUniversalLogin{}
+	b.GetBody()
+	b = nil
+	b.GetBody()
+}
+
+func TestBrandingUniversalLogin_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &BrandingUniversalLogin{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
 func TestBreachedPasswordDetection_GetAdminNotificationFrequency(tt *testing.T) {
@@ -2137,6 +2788,16 @@ func TestClientAuthenticationMethods_String(t *testing.T) {
 	}
 }
 
+func TestClientGrant_GetAllowAnyOrganization(tt *testing.T) {
+	var zeroValue bool
+	c := &ClientGrant{AllowAnyOrganization: &zeroValue}
+	c.GetAllowAnyOrganization()
+	c = &ClientGrant{}
+	c.GetAllowAnyOrganization()
+	c = nil
+	c.GetAllowAnyOrganization()
+}
+
 func TestClientGrant_GetAudience(tt *testing.T) {
 	var zeroValue string
 	c := &ClientGrant{Audience: &zeroValue}
@@ -2167,6 +2828,16 @@ func TestClientGrant_GetID(tt *testing.T) {
 	c.GetID()
 }
 
+func TestClientGrant_GetOrganizationUsage(tt *testing.T) {
+	var zeroValue string
+	c := &ClientGrant{OrganizationUsage: &zeroValue}
+	c.GetOrganizationUsage()
+	c = &ClientGrant{}
+	c.GetOrganizationUsage()
+	c = nil
+	c.GetOrganizationUsage()
+}
+
 func TestClientGrant_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientGrant{}
@@ -7276,6 +7947,14 @@ func TestCustomDomain_String(t *testing.T) {
 	}
 }
 
+func TestCustomDomainDNSRecord_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomDomainDNSRecord{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestCustomDomainVerification_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &CustomDomainVerification{}
@@ -7284,6 +7963,14 @@ func TestCustomDomainVerification_String(t *testing.T) {
 	}
 }
 
+func TestCustomDomainVerifyOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomDomainVerifyOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestDailyStat_GetCreatedAt(tt *testing.T) {
 	var zeroValue time.Time
 	d := &DailyStat{CreatedAt: &zeroValue}
@@ -7352,28 +8039,114 @@ func TestDailyStat_String(t *testing.T) {
 	}
 }
 
-func TestDropboxClientAddon_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &DropboxClientAddon{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestDeviceCredential_GetClientID(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{ClientID: &zeroValue}
+	d.GetClientID()
+	d = &DeviceCredential{}
+	d.GetClientID()
+	d = nil
+	d.GetClientID()
 }
 
-func TestEchoSignClientAddon_GetDomain(tt *testing.T) {
+func TestDeviceCredential_GetDeviceID(tt *testing.T) {
 	var zeroValue string
-	e := &EchoSignClientAddon{Domain: &zeroValue}
-	e.GetDomain()
-	e = &EchoSignClientAddon{}
-	e.GetDomain()
-	e = nil
-	e.GetDomain()
+	d := &DeviceCredential{DeviceID: &zeroValue}
+	d.GetDeviceID()
+	d = &DeviceCredential{}
+	d.GetDeviceID()
+	d = nil
+	d.GetDeviceID()
 }
 
-func TestEchoSignClientAddon_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &EchoSignClientAddon{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+func TestDeviceCredential_GetDeviceName(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{DeviceName: &zeroValue}
+	d.GetDeviceName()
+	d = &DeviceCredential{}
+	d.GetDeviceName()
+	d = nil
+	d.GetDeviceName()
+}
+
+func TestDeviceCredential_GetID(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{ID: &zeroValue}
+	d.GetID()
+	d = &DeviceCredential{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDeviceCredential_GetType(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{Type: &zeroValue}
+	d.GetType()
+	d = &DeviceCredential{}
+	d.GetType()
+	d = nil
+	d.GetType()
+}
+
+func TestDeviceCredential_GetUserID(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{UserID: &zeroValue}
+	d.GetUserID()
+	d = &DeviceCredential{}
+	d.GetUserID()
+	d = nil
+	d.GetUserID()
+}
+
+func TestDeviceCredential_GetValue(tt *testing.T) {
+	var zeroValue string
+	d := &DeviceCredential{Value: &zeroValue}
+	d.GetValue()
+	d = &DeviceCredential{}
+	d.GetValue()
+	d = nil
+	d.GetValue()
+}
+
+func TestDeviceCredential_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &DeviceCredential{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestDeviceCredentialList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &DeviceCredentialList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestDropboxClientAddon_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &DropboxClientAddon{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestEchoSignClientAddon_GetDomain(tt *testing.T) {
+	var zeroValue string
+	e := &EchoSignClientAddon{Domain: &zeroValue}
+	e.GetDomain()
+	e = &EchoSignClientAddon{}
+	e.GetDomain()
+	e = nil
+	e.GetDomain()
+}
+
+func TestEchoSignClientAddon_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EchoSignClientAddon{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
@@ -7434,6 +8207,14 @@ func TestEmailProvider_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCheck_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EmailProviderCheck{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEmailProviderCredentialsAzureCS_GetConnectionString(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsAzureCS{ConnectionString: &zeroValue}
@@ -7787,6 +8568,14 @@ func TestEmailProviderSettingsSMTPHeaders_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderVerification_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EmailProviderVerification{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEmailTemplate_GetBody(tt *testing.T) {
 	var zeroValue string
 	e := &EmailTemplate{Body: &zeroValue}
@@ -7885,6 +8674,110 @@ func TestEmailTemplate_String(t *testing.T) {
 	}
 }
 
+func TestEmailTemplateContext_GetClient(tt *testing.T) {
+	e := &EmailTemplateContext{}
+	e.GetClient()
+	e = nil
+	e.GetClient()
+}
+
+func TestEmailTemplateContext_GetCode(tt *testing.T) {
+	var zeroValue string
+	e := &EmailTemplateContext{Code: &zeroValue}
+	e.GetCode()
+	e = &EmailTemplateContext{}
+	e.GetCode()
+	e = nil
+	e.GetCode()
+}
+
+func TestEmailTemplateContext_GetConnectionName(tt *testing.T) {
+	var zeroValue string
+	e := &EmailTemplateContext{ConnectionName: &zeroValue}
+	e.GetConnectionName()
+	e = &EmailTemplateContext{}
+	e.GetConnectionName()
+	e = nil
+	e.GetConnectionName()
+}
+
+func TestEmailTemplateContext_GetExtra(tt *testing.T) {
+	zeroValue := map[string]interface{}{}
+	e := &EmailTemplateContext{Extra: zeroValue}
+	e.GetExtra()
+	e = &EmailTemplateContext{}
+	e.GetExtra()
+	e = nil
+	e.GetExtra()
+}
+
+func TestEmailTemplateContext_GetOrganization(tt *testing.T) {
+	e := &EmailTemplateContext{}
+	e.GetOrganization()
+	e = nil
+	e.GetOrganization()
+}
+
+func TestEmailTemplateContext_GetRequestLanguage(tt *testing.T) {
+	var zeroValue string
+	e := &EmailTemplateContext{RequestLanguage: &zeroValue}
+	e.GetRequestLanguage()
+	e = &EmailTemplateContext{}
+	e.GetRequestLanguage()
+	e = nil
+	e.GetRequestLanguage()
+}
+
+func TestEmailTemplateContext_GetTenant(tt *testing.T) {
+	e := &EmailTemplateContext{}
+	e.GetTenant()
+	e = nil
+	e.GetTenant()
+}
+
+func TestEmailTemplateContext_GetTenantName(tt *testing.T) {
+	var zeroValue string
+	e := &EmailTemplateContext{TenantName: &zeroValue}
+	e.GetTenantName()
+	e = &EmailTemplateContext{}
+	e.GetTenantName()
+	e = nil
+	e.GetTenantName()
+}
+
+func TestEmailTemplateContext_GetURL(tt *testing.T) {
+	var zeroValue string
+	e := &EmailTemplateContext{URL: &zeroValue}
+	e.GetURL()
+	e = &EmailTemplateContext{}
+	e.GetURL()
+	e = nil
+	e.GetURL()
+}
+
+func TestEmailTemplateContext_GetUser(tt *testing.T) {
+	e := &EmailTemplateContext{}
+	e.GetUser()
+	e = nil
+	e.GetUser()
+}
+
+func TestEmailTemplateContext_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EmailTemplateContext{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestEmailTemplatePreview_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EmailTemplatePreview{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEnrollment_GetEnrolledAt(tt *testing.T) {
 	var zeroValue time.Time
 	e := &Enrollment{EnrolledAt: &zeroValue}
@@ -8721,6 +9614,14 @@ func TestLog_String(t *testing.T) {
 	}
 }
 
+func TestLogList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestLogStream_GetFilters(tt *testing.T) {
 	var zeroValue []map[string]string
 	l := &LogStream{Filters: &zeroValue}
@@ -8779,6 +9680,21 @@ func TestLogStream_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamResumeAttempt_GetLogStream(tt *testing.T) {
+	l := &LogStreamResumeAttempt{}
+	l.GetLogStream()
+	l = nil
+	l.GetLogStream()
+}
+
+func TestLogStreamResumeAttempt_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogStreamResumeAttempt{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestLogStreamSinkAmazonEventBridge_GetAccountID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkAmazonEventBridge{AccountID: &zeroValue}
@@ -9083,6 +9999,29 @@ func TestLogStreamSinkSumo_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamStatusChange_GetLogStream(tt *testing.T) {
+	l := &LogStreamStatusChange{}
+	l.GetLogStream()
+	l = nil
+	l.GetLogStream()
+}
+
+func TestLogStreamStatusChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &LogStreamStatusChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestMemoryDNSProvider_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &MemoryDNSProvider{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestMSCRMClientAddon_GetURL(tt *testing.T) {
 	var zeroValue string
 	m := &MSCRMClientAddon{URL: &zeroValue}
@@ -10263,6 +11202,205 @@ func TestPrompt_String(t *testing.T) {
 	}
 }
 
+func TestPromptScreenPartials_GetFormContentEnd(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{FormContentEnd: &zeroValue}
+	p.GetFormContentEnd()
+	p = &PromptScreenPartials{}
+	p.GetFormContentEnd()
+	p = nil
+	p.GetFormContentEnd()
+}
+
+func TestPromptScreenPartials_GetFormContentStart(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{FormContentStart: &zeroValue}
+	p.GetFormContentStart()
+	p = &PromptScreenPartials{}
+	p.GetFormContentStart()
+	p = nil
+	p.GetFormContentStart()
+}
+
+func TestPromptScreenPartials_GetFormFooterEnd(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{FormFooterEnd: &zeroValue}
+	p.GetFormFooterEnd()
+	p = &PromptScreenPartials{}
+	p.GetFormFooterEnd()
+	p = nil
+	p.GetFormFooterEnd()
+}
+
+func TestPromptScreenPartials_GetFormFooterStart(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{FormFooterStart: &zeroValue}
+	p.GetFormFooterStart()
+	p = &PromptScreenPartials{}
+	p.GetFormFooterStart()
+	p = nil
+	p.GetFormFooterStart()
+}
+
+func TestPromptScreenPartials_GetSecondaryActionsEnd(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{SecondaryActionsEnd: &zeroValue}
+	p.GetSecondaryActionsEnd()
+	p = &PromptScreenPartials{}
+	p.GetSecondaryActionsEnd()
+	p = nil
+	p.GetSecondaryActionsEnd()
+}
+
+func TestPromptScreenPartials_GetSecondaryActionsStart(tt *testing.T) {
+	var zeroValue string
+	p := &PromptScreenPartials{SecondaryActionsStart: &zeroValue}
+	p.GetSecondaryActionsStart()
+	p = &PromptScreenPartials{}
+	p.GetSecondaryActionsStart()
+	p = nil
+	p.GetSecondaryActionsStart()
+}
+
+func TestPromptScreenPartials_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PromptScreenPartials{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRefreshToken_GetClientID(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshToken{ClientID: &zeroValue}
+	r.GetClientID()
+	r = &RefreshToken{}
+	r.GetClientID()
+	r = nil
+	r.GetClientID()
+}
+
+func TestRefreshToken_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RefreshToken{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RefreshToken{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
+}
+
+func TestRefreshToken_GetDevice(tt *testing.T) {
+	r := &RefreshToken{}
+	r.GetDevice()
+	r = nil
+	r.GetDevice()
+}
+
+func TestRefreshToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RefreshToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RefreshToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
+}
+
+func TestRefreshToken_GetID(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshToken{ID: &zeroValue}
+	r.GetID()
+	r = &RefreshToken{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRefreshToken_GetIdleExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RefreshToken{IdleExpiresAt: &zeroValue}
+	r.GetIdleExpiresAt()
+	r = &RefreshToken{}
+	r.GetIdleExpiresAt()
+	r = nil
+	r.GetIdleExpiresAt()
+}
+
+func TestRefreshToken_GetRotating(tt *testing.T) {
+	var zeroValue bool
+	r := &RefreshToken{Rotating: &zeroValue}
+	r.GetRotating()
+	r = &RefreshToken{}
+	r.GetRotating()
+	r = nil
+	r.GetRotating()
+}
+
+func TestRefreshToken_GetSessionID(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshToken{SessionID: &zeroValue}
+	r.GetSessionID()
+	r = &RefreshToken{}
+	r.GetSessionID()
+	r = nil
+	r.GetSessionID()
+}
+
+func TestRefreshToken_GetUserID(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshToken{UserID: &zeroValue}
+	r.GetUserID()
+	r = &RefreshToken{}
+	r.GetUserID()
+	r = nil
+	r.GetUserID()
+}
+
+func TestRefreshToken_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RefreshToken{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRefreshTokenList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RefreshTokenList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRefreshTokenResourceServer_GetAudience(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshTokenResourceServer{Audience: &zeroValue}
+	r.GetAudience()
+	r = &RefreshTokenResourceServer{}
+	r.GetAudience()
+	r = nil
+	r.GetAudience()
+}
+
+func TestRefreshTokenResourceServer_GetScopes(tt *testing.T) {
+	var zeroValue string
+	r := &RefreshTokenResourceServer{Scopes: &zeroValue}
+	r.GetScopes()
+	r = &RefreshTokenResourceServer{}
+	r.GetScopes()
+	r = nil
+	r.GetScopes()
+}
+
+func TestRefreshTokenResourceServer_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RefreshTokenResourceServer{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestResourceServer_GetAllowOfflineAccess(tt *testing.T) {
 	var zeroValue bool
 	r := &ResourceServer{AllowOfflineAccess: &zeroValue}
@@ -10766,293 +11904,1094 @@ func TestSAML2ClientAddon_GetDestination(tt *testing.T) {
 	s = &SAML2ClientAddon{}
 	s.GetDestination()
 	s = nil
-	s.GetDestination()
+	s.GetDestination()
+}
+
+func TestSAML2ClientAddon_GetDigestAlgorithm(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{DigestAlgorithm: &zeroValue}
+	s.GetDigestAlgorithm()
+	s = &SAML2ClientAddon{}
+	s.GetDigestAlgorithm()
+	s = nil
+	s.GetDigestAlgorithm()
+}
+
+func TestSAML2ClientAddon_GetIncludeAttributeNameFormat(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{IncludeAttributeNameFormat: &zeroValue}
+	s.GetIncludeAttributeNameFormat()
+	s = &SAML2ClientAddon{}
+	s.GetIncludeAttributeNameFormat()
+	s = nil
+	s.GetIncludeAttributeNameFormat()
+}
+
+func TestSAML2ClientAddon_GetIssuer(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{Issuer: &zeroValue}
+	s.GetIssuer()
+	s = &SAML2ClientAddon{}
+	s.GetIssuer()
+	s = nil
+	s.GetIssuer()
+}
+
+func TestSAML2ClientAddon_GetLifetimeInSeconds(tt *testing.T) {
+	var zeroValue int
+	s := &SAML2ClientAddon{LifetimeInSeconds: &zeroValue}
+	s.GetLifetimeInSeconds()
+	s = &SAML2ClientAddon{}
+	s.GetLifetimeInSeconds()
+	s = nil
+	s.GetLifetimeInSeconds()
+}
+
+func TestSAML2ClientAddon_GetLogout(tt *testing.T) {
+	s := &SAML2ClientAddon{}
+	s.GetLogout()
+	s = nil
+	s.GetLogout()
+}
+
+func TestSAML2ClientAddon_GetMapIdentities(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{MapIdentities: &zeroValue}
+	s.GetMapIdentities()
+	s = &SAML2ClientAddon{}
+	s.GetMapIdentities()
+	s = nil
+	s.GetMapIdentities()
+}
+
+func TestSAML2ClientAddon_GetMappings(tt *testing.T) {
+	var zeroValue map[string]string
+	s := &SAML2ClientAddon{Mappings: &zeroValue}
+	s.GetMappings()
+	s = &SAML2ClientAddon{}
+	s.GetMappings()
+	s = nil
+	s.GetMappings()
+}
+
+func TestSAML2ClientAddon_GetMapUnknownClaimsAsIs(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{MapUnknownClaimsAsIs: &zeroValue}
+	s.GetMapUnknownClaimsAsIs()
+	s = &SAML2ClientAddon{}
+	s.GetMapUnknownClaimsAsIs()
+	s = nil
+	s.GetMapUnknownClaimsAsIs()
+}
+
+func TestSAML2ClientAddon_GetNameIdentifierFormat(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{NameIdentifierFormat: &zeroValue}
+	s.GetNameIdentifierFormat()
+	s = &SAML2ClientAddon{}
+	s.GetNameIdentifierFormat()
+	s = nil
+	s.GetNameIdentifierFormat()
+}
+
+func TestSAML2ClientAddon_GetNameIdentifierProbes(tt *testing.T) {
+	var zeroValue []string
+	s := &SAML2ClientAddon{NameIdentifierProbes: &zeroValue}
+	s.GetNameIdentifierProbes()
+	s = &SAML2ClientAddon{}
+	s.GetNameIdentifierProbes()
+	s = nil
+	s.GetNameIdentifierProbes()
+}
+
+func TestSAML2ClientAddon_GetPassthroughClaimsWithNoMapping(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{PassthroughClaimsWithNoMapping: &zeroValue}
+	s.GetPassthroughClaimsWithNoMapping()
+	s = &SAML2ClientAddon{}
+	s.GetPassthroughClaimsWithNoMapping()
+	s = nil
+	s.GetPassthroughClaimsWithNoMapping()
+}
+
+func TestSAML2ClientAddon_GetRecipient(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{Recipient: &zeroValue}
+	s.GetRecipient()
+	s = &SAML2ClientAddon{}
+	s.GetRecipient()
+	s = nil
+	s.GetRecipient()
+}
+
+func TestSAML2ClientAddon_GetSignatureAlgorithm(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{SignatureAlgorithm: &zeroValue}
+	s.GetSignatureAlgorithm()
+	s = &SAML2ClientAddon{}
+	s.GetSignatureAlgorithm()
+	s = nil
+	s.GetSignatureAlgorithm()
+}
+
+func TestSAML2ClientAddon_GetSigningCert(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddon{SigningCert: &zeroValue}
+	s.GetSigningCert()
+	s = &SAML2ClientAddon{}
+	s.GetSigningCert()
+	s = nil
+	s.GetSigningCert()
+}
+
+func TestSAML2ClientAddon_GetSignResponse(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{SignResponse: &zeroValue}
+	s.GetSignResponse()
+	s = &SAML2ClientAddon{}
+	s.GetSignResponse()
+	s = nil
+	s.GetSignResponse()
+}
+
+func TestSAML2ClientAddon_GetTypedAttributes(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddon{TypedAttributes: &zeroValue}
+	s.GetTypedAttributes()
+	s = &SAML2ClientAddon{}
+	s.GetTypedAttributes()
+	s = nil
+	s.GetTypedAttributes()
+}
+
+func TestSAML2ClientAddon_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SAML2ClientAddon{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSAML2ClientAddonLogout_GetCallback(tt *testing.T) {
+	var zeroValue string
+	s := &SAML2ClientAddonLogout{Callback: &zeroValue}
+	s.GetCallback()
+	s = &SAML2ClientAddonLogout{}
+	s.GetCallback()
+	s = nil
+	s.GetCallback()
+}
+
+func TestSAML2ClientAddonLogout_GetSLOEnabled(tt *testing.T) {
+	var zeroValue bool
+	s := &SAML2ClientAddonLogout{SLOEnabled: &zeroValue}
+	s.GetSLOEnabled()
+	s = &SAML2ClientAddonLogout{}
+	s.GetSLOEnabled()
+	s = nil
+	s.GetSLOEnabled()
+}
+
+func TestSAML2ClientAddonLogout_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SAML2ClientAddonLogout{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSAPAPIClientAddon_GetClientID(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{ClientID: &zeroValue}
+	s.GetClientID()
+	s = &SAPAPIClientAddon{}
+	s.GetClientID()
+	s = nil
+	s.GetClientID()
+}
+
+func TestSAPAPIClientAddon_GetNameIdentifierFormat(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{NameIdentifierFormat: &zeroValue}
+	s.GetNameIdentifierFormat()
+	s = &SAPAPIClientAddon{}
+	s.GetNameIdentifierFormat()
+	s = nil
+	s.GetNameIdentifierFormat()
+}
+
+func TestSAPAPIClientAddon_GetScope(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{Scope: &zeroValue}
+	s.GetScope()
+	s = &SAPAPIClientAddon{}
+	s.GetScope()
+	s = nil
+	s.GetScope()
+}
+
+func TestSAPAPIClientAddon_GetServicePassword(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{ServicePassword: &zeroValue}
+	s.GetServicePassword()
+	s = &SAPAPIClientAddon{}
+	s.GetServicePassword()
+	s = nil
+	s.GetServicePassword()
+}
+
+func TestSAPAPIClientAddon_GetTokenEndpointURL(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{TokenEndpointURL: &zeroValue}
+	s.GetTokenEndpointURL()
+	s = &SAPAPIClientAddon{}
+	s.GetTokenEndpointURL()
+	s = nil
+	s.GetTokenEndpointURL()
+}
+
+func TestSAPAPIClientAddon_GetUsernameAttribute(tt *testing.T) {
+	var zeroValue string
+	s := &SAPAPIClientAddon{UsernameAttribute: &zeroValue}
+	s.GetUsernameAttribute()
+	s = &SAPAPIClientAddon{}
+	s.GetUsernameAttribute()
+	s = nil
+	s.GetUsernameAttribute()
+}
+
+func TestSAPAPIClientAddon_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SAPAPIClientAddon{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSCIMConfiguration_GetConnectionID(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfiguration{ConnectionID: &zeroValue}
+	s.GetConnectionID()
+	s = &SCIMConfiguration{}
+	s.GetConnectionID()
+	s = nil
+	s.GetConnectionID()
+}
+
+func TestSCIMConfiguration_GetConnectionName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfiguration{ConnectionName: &zeroValue}
+	s.GetConnectionName()
+	s = &SCIMConfiguration{}
+	s.GetConnectionName()
+	s = nil
+	s.GetConnectionName()
+}
+
+func TestSCIMConfiguration_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SCIMConfiguration{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SCIMConfiguration{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSCIMConfiguration_GetMapping(tt *testing.T) {
+	var zeroValue []SCIMConfigurationMapping
+	s := &SCIMConfiguration{Mapping: &zeroValue}
+	s.GetMapping()
+	s = &SCIMConfiguration{}
+	s.GetMapping()
+	s = nil
+	s.GetMapping()
+}
+
+func TestSCIMConfiguration_GetStrategy(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfiguration{Strategy: &zeroValue}
+	s.GetStrategy()
+	s = &SCIMConfiguration{}
+	s.GetStrategy()
+	s = nil
+	s.GetStrategy()
+}
+
+func TestSCIMConfiguration_GetTenantName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfiguration{TenantName: &zeroValue}
+	s.GetTenantName()
+	s = &SCIMConfiguration{}
+	s.GetTenantName()
+	s = nil
+	s.GetTenantName()
+}
+
+func TestSCIMConfiguration_GetUpdatedOn(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SCIMConfiguration{UpdatedOn: &zeroValue}
+	s.GetUpdatedOn()
+	s = &SCIMConfiguration{}
+	s.GetUpdatedOn()
+	s = nil
+	s.GetUpdatedOn()
+}
+
+func TestSCIMConfiguration_GetUserIDAttribute(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfiguration{UserIDAttribute: &zeroValue}
+	s.GetUserIDAttribute()
+	s = &SCIMConfiguration{}
+	s.GetUserIDAttribute()
+	s = nil
+	s.GetUserIDAttribute()
+}
+
+func TestSCIMConfiguration_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SCIMConfiguration{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSCIMConfigurationMapping_GetAuth0(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfigurationMapping{Auth0: &zeroValue}
+	s.GetAuth0()
+	s = &SCIMConfigurationMapping{}
+	s.GetAuth0()
+	s = nil
+	s.GetAuth0()
+}
+
+func TestSCIMConfigurationMapping_GetSCIM(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMConfigurationMapping{SCIM: &zeroValue}
+	s.GetSCIM()
+	s = &SCIMConfigurationMapping{}
+	s.GetSCIM()
+	s = nil
+	s.GetSCIM()
+}
+
+func TestSCIMConfigurationMapping_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SCIMConfigurationMapping{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSCIMToken_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SCIMToken{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SCIMToken{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSCIMToken_GetLastUsedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SCIMToken{LastUsedAt: &zeroValue}
+	s.GetLastUsedAt()
+	s = &SCIMToken{}
+	s.GetLastUsedAt()
+	s = nil
+	s.GetLastUsedAt()
+}
+
+func TestSCIMToken_GetScopes(tt *testing.T) {
+	var zeroValue []string
+	s := &SCIMToken{Scopes: &zeroValue}
+	s.GetScopes()
+	s = &SCIMToken{}
+	s.GetScopes()
+	s = nil
+	s.GetScopes()
+}
+
+func TestSCIMToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMToken{Token: &zeroValue}
+	s.GetToken()
+	s = &SCIMToken{}
+	s.GetToken()
+	s = nil
+	s.GetToken()
+}
+
+func TestSCIMToken_GetTokenID(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMToken{TokenID: &zeroValue}
+	s.GetTokenID()
+	s = &SCIMToken{}
+	s.GetTokenID()
+	s = nil
+	s.GetTokenID()
+}
+
+func TestSCIMToken_GetTokenLifeTime(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMToken{TokenLifeTime: &zeroValue}
+	s.GetTokenLifeTime()
+	s = &SCIMToken{}
+	s.GetTokenLifeTime()
+	s = nil
+	s.GetTokenLifeTime()
+}
+
+func TestSCIMToken_GetValidUntil(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SCIMToken{ValidUntil: &zeroValue}
+	s.GetValidUntil()
+	s = &SCIMToken{}
+	s.GetValidUntil()
+	s = nil
+	s.GetValidUntil()
+}
+
+func TestSCIMToken_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SCIMToken{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfile_GetAllowedStrategies(tt *testing.T) {
+	var zeroValue []string
+	s := &SelfServiceProfile{AllowedStrategies: &zeroValue}
+	s.GetAllowedStrategies()
+	s = &SelfServiceProfile{}
+	s.GetAllowedStrategies()
+	s = nil
+	s.GetAllowedStrategies()
+}
+
+func TestSelfServiceProfile_GetBranding(tt *testing.T) {
+	s := &SelfServiceProfile{}
+	s.GetBranding()
+	s = nil
+	s.GetBranding()
+}
+
+func TestSelfServiceProfile_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SelfServiceProfile{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SelfServiceProfile{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSelfServiceProfile_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfile{Description: &zeroValue}
+	s.GetDescription()
+	s = &SelfServiceProfile{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSelfServiceProfile_GetID(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfile{ID: &zeroValue}
+	s.GetID()
+	s = &SelfServiceProfile{}
+	s.GetID()
+	s = nil
+	s.GetID()
+}
+
+func TestSelfServiceProfile_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfile{Name: &zeroValue}
+	s.GetName()
+	s = &SelfServiceProfile{}
+	s.GetName()
+	s = nil
+	s.GetName()
+}
+
+func TestSelfServiceProfile_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SelfServiceProfile{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SelfServiceProfile{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
+}
+
+func TestSelfServiceProfile_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfile{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileBranding_GetColors(tt *testing.T) {
+	s := &SelfServiceProfileBranding{}
+	s.GetColors()
+	s = nil
+	s.GetColors()
+}
+
+func TestSelfServiceProfileBranding_GetLogoURL(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileBranding{LogoURL: &zeroValue}
+	s.GetLogoURL()
+	s = &SelfServiceProfileBranding{}
+	s.GetLogoURL()
+	s = nil
+	s.GetLogoURL()
+}
+
+func TestSelfServiceProfileBranding_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileBranding{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileBrandingColors_GetPrimary(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileBrandingColors{Primary: &zeroValue}
+	s.GetPrimary()
+	s = &SelfServiceProfileBrandingColors{}
+	s.GetPrimary()
+	s = nil
+	s.GetPrimary()
+}
+
+func TestSelfServiceProfileBrandingColors_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileBrandingColors{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileTicket_GetConnectionConfig(tt *testing.T) {
+	s := &SelfServiceProfileTicket{}
+	s.GetConnectionConfig()
+	s = nil
+	s.GetConnectionConfig()
+}
+
+func TestSelfServiceProfileTicket_GetConnectionID(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicket{ConnectionID: &zeroValue}
+	s.GetConnectionID()
+	s = &SelfServiceProfileTicket{}
+	s.GetConnectionID()
+	s = nil
+	s.GetConnectionID()
+}
+
+func TestSelfServiceProfileTicket_GetDomainAliasesConfig(tt *testing.T) {
+	s := &SelfServiceProfileTicket{}
+	s.GetDomainAliasesConfig()
+	s = nil
+	s.GetDomainAliasesConfig()
+}
+
+func TestSelfServiceProfileTicket_GetTicket(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicket{Ticket: &zeroValue}
+	s.GetTicket()
+	s = &SelfServiceProfileTicket{}
+	s.GetTicket()
+	s = nil
+	s.GetTicket()
+}
+
+func TestSelfServiceProfileTicket_GetTTLSec(tt *testing.T) {
+	var zeroValue int
+	s := &SelfServiceProfileTicket{TTLSec: &zeroValue}
+	s.GetTTLSec()
+	s = &SelfServiceProfileTicket{}
+	s.GetTTLSec()
+	s = nil
+	s.GetTTLSec()
+}
+
+func TestSelfServiceProfileTicket_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileTicket{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicketConnectionConfig{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SelfServiceProfileTicketConnectionConfig{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetIsDomainConnection(tt *testing.T) {
+	var zeroValue bool
+	s := &SelfServiceProfileTicketConnectionConfig{IsDomainConnection: &zeroValue}
+	s.GetIsDomainConnection()
+	s = &SelfServiceProfileTicketConnectionConfig{}
+	s.GetIsDomainConnection()
+	s = nil
+	s.GetIsDomainConnection()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetMetadata(tt *testing.T) {
+	var zeroValue map[string]string
+	s := &SelfServiceProfileTicketConnectionConfig{Metadata: &zeroValue}
+	s.GetMetadata()
+	s = &SelfServiceProfileTicketConnectionConfig{}
+	s.GetMetadata()
+	s = nil
+	s.GetMetadata()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicketConnectionConfig{Name: &zeroValue}
+	s.GetName()
+	s = &SelfServiceProfileTicketConnectionConfig{}
+	s.GetName()
+	s = nil
+	s.GetName()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetOptions(tt *testing.T) {
+	s := &SelfServiceProfileTicketConnectionConfig{}
+	s.GetOptions()
+	s = nil
+	s.GetOptions()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_GetShowAsButton(tt *testing.T) {
+	var zeroValue bool
+	s := &SelfServiceProfileTicketConnectionConfig{ShowAsButton: &zeroValue}
+	s.GetShowAsButton()
+	s = &SelfServiceProfileTicketConnectionConfig{}
+	s.GetShowAsButton()
+	s = nil
+	s.GetShowAsButton()
+}
+
+func TestSelfServiceProfileTicketConnectionConfig_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileTicketConnectionConfig{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileTicketConnectionOptions_GetDomainAliases(tt *testing.T) {
+	var zeroValue []string
+	s := &SelfServiceProfileTicketConnectionOptions{DomainAliases: &zeroValue}
+	s.GetDomainAliases()
+	s = &SelfServiceProfileTicketConnectionOptions{}
+	s.GetDomainAliases()
+	s = nil
+	s.GetDomainAliases()
+}
+
+func TestSelfServiceProfileTicketConnectionOptions_GetIconURL(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicketConnectionOptions{IconURL: &zeroValue}
+	s.GetIconURL()
+	s = &SelfServiceProfileTicketConnectionOptions{}
+	s.GetIconURL()
+	s = nil
+	s.GetIconURL()
+}
+
+func TestSelfServiceProfileTicketConnectionOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileTicketConnectionOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileTicketDomainAliasesConfig_GetDomainVerification(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicketDomainAliasesConfig{DomainVerification: &zeroValue}
+	s.GetDomainVerification()
+	s = &SelfServiceProfileTicketDomainAliasesConfig{}
+	s.GetDomainVerification()
+	s = nil
+	s.GetDomainVerification()
+}
+
+func TestSelfServiceProfileTicketDomainAliasesConfig_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileTicketDomainAliasesConfig{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileTicketEnabledOrganization_GetAssignMembershipOnLogin(tt *testing.T) {
+	var zeroValue bool
+	s := &SelfServiceProfileTicketEnabledOrganization{AssignMembershipOnLogin: &zeroValue}
+	s.GetAssignMembershipOnLogin()
+	s = &SelfServiceProfileTicketEnabledOrganization{}
+	s.GetAssignMembershipOnLogin()
+	s = nil
+	s.GetAssignMembershipOnLogin()
+}
+
+func TestSelfServiceProfileTicketEnabledOrganization_GetOrganizationID(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileTicketEnabledOrganization{OrganizationID: &zeroValue}
+	s.GetOrganizationID()
+	s = &SelfServiceProfileTicketEnabledOrganization{}
+	s.GetOrganizationID()
+	s = nil
+	s.GetOrganizationID()
+}
+
+func TestSelfServiceProfileTicketEnabledOrganization_GetShowAsButton(tt *testing.T) {
+	var zeroValue bool
+	s := &SelfServiceProfileTicketEnabledOrganization{ShowAsButton: &zeroValue}
+	s.GetShowAsButton()
+	s = &SelfServiceProfileTicketEnabledOrganization{}
+	s.GetShowAsButton()
+	s = nil
+	s.GetShowAsButton()
+}
+
+func TestSelfServiceProfileTicketEnabledOrganization_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileTicketEnabledOrganization{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSelfServiceProfileUserAttribute_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileUserAttribute{Description: &zeroValue}
+	s.GetDescription()
+	s = &SelfServiceProfileUserAttribute{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSelfServiceProfileUserAttribute_GetIsOptional(tt *testing.T) {
+	var zeroValue bool
+	s := &SelfServiceProfileUserAttribute{IsOptional: &zeroValue}
+	s.GetIsOptional()
+	s = &SelfServiceProfileUserAttribute{}
+	s.GetIsOptional()
+	s = nil
+	s.GetIsOptional()
+}
+
+func TestSelfServiceProfileUserAttribute_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SelfServiceProfileUserAttribute{Name: &zeroValue}
+	s.GetName()
+	s = &SelfServiceProfileUserAttribute{}
+	s.GetName()
+	s = nil
+	s.GetName()
+}
+
+func TestSelfServiceProfileUserAttribute_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SelfServiceProfileUserAttribute{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSentryClientAddon_GetBaseURL(tt *testing.T) {
+	var zeroValue string
+	s := &SentryClientAddon{BaseURL: &zeroValue}
+	s.GetBaseURL()
+	s = &SentryClientAddon{}
+	s.GetBaseURL()
+	s = nil
+	s.GetBaseURL()
 }
 
-func TestSAML2ClientAddon_GetDigestAlgorithm(tt *testing.T) {
+func TestSentryClientAddon_GetOrgSlug(tt *testing.T) {
 	var zeroValue string
-	s := &SAML2ClientAddon{DigestAlgorithm: &zeroValue}
-	s.GetDigestAlgorithm()
-	s = &SAML2ClientAddon{}
-	s.GetDigestAlgorithm()
+	s := &SentryClientAddon{OrgSlug: &zeroValue}
+	s.GetOrgSlug()
+	s = &SentryClientAddon{}
+	s.GetOrgSlug()
 	s = nil
-	s.GetDigestAlgorithm()
+	s.GetOrgSlug()
 }
 
-func TestSAML2ClientAddon_GetIncludeAttributeNameFormat(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{IncludeAttributeNameFormat: &zeroValue}
-	s.GetIncludeAttributeNameFormat()
-	s = &SAML2ClientAddon{}
-	s.GetIncludeAttributeNameFormat()
-	s = nil
-	s.GetIncludeAttributeNameFormat()
+func TestSentryClientAddon_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SentryClientAddon{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
-func TestSAML2ClientAddon_GetIssuer(tt *testing.T) {
-	var zeroValue string
-	s := &SAML2ClientAddon{Issuer: &zeroValue}
-	s.GetIssuer()
-	s = &SAML2ClientAddon{}
-	s.GetIssuer()
+func TestSession_GetAuthenticatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{AuthenticatedAt: &zeroValue}
+	s.GetAuthenticatedAt()
+	s = &Session{}
+	s.GetAuthenticatedAt()
 	s = nil
-	s.GetIssuer()
+	s.GetAuthenticatedAt()
 }
 
-func TestSAML2ClientAddon_GetLifetimeInSeconds(tt *testing.T) {
-	var zeroValue int
-	s := &SAML2ClientAddon{LifetimeInSeconds: &zeroValue}
-	s.GetLifetimeInSeconds()
-	s = &SAML2ClientAddon{}
-	s.GetLifetimeInSeconds()
+func TestSession_GetAuthentication(tt *testing.T) {
+	s := &Session{}
+	s.GetAuthentication()
 	s = nil
-	s.GetLifetimeInSeconds()
+	s.GetAuthentication()
 }
 
-func TestSAML2ClientAddon_GetLogout(tt *testing.T) {
-	s := &SAML2ClientAddon{}
-	s.GetLogout()
+func TestSession_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &Session{}
+	s.GetCreatedAt()
 	s = nil
-	s.GetLogout()
+	s.GetCreatedAt()
 }
 
-func TestSAML2ClientAddon_GetMapIdentities(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{MapIdentities: &zeroValue}
-	s.GetMapIdentities()
-	s = &SAML2ClientAddon{}
-	s.GetMapIdentities()
+func TestSession_GetDevice(tt *testing.T) {
+	s := &Session{}
+	s.GetDevice()
 	s = nil
-	s.GetMapIdentities()
+	s.GetDevice()
 }
 
-func TestSAML2ClientAddon_GetMappings(tt *testing.T) {
-	var zeroValue map[string]string
-	s := &SAML2ClientAddon{Mappings: &zeroValue}
-	s.GetMappings()
-	s = &SAML2ClientAddon{}
-	s.GetMappings()
+func TestSession_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{ExpiresAt: &zeroValue}
+	s.GetExpiresAt()
+	s = &Session{}
+	s.GetExpiresAt()
 	s = nil
-	s.GetMappings()
+	s.GetExpiresAt()
 }
 
-func TestSAML2ClientAddon_GetMapUnknownClaimsAsIs(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{MapUnknownClaimsAsIs: &zeroValue}
-	s.GetMapUnknownClaimsAsIs()
-	s = &SAML2ClientAddon{}
-	s.GetMapUnknownClaimsAsIs()
+func TestSession_GetID(tt *testing.T) {
+	var zeroValue string
+	s := &Session{ID: &zeroValue}
+	s.GetID()
+	s = &Session{}
+	s.GetID()
 	s = nil
-	s.GetMapUnknownClaimsAsIs()
+	s.GetID()
 }
 
-func TestSAML2ClientAddon_GetNameIdentifierFormat(tt *testing.T) {
-	var zeroValue string
-	s := &SAML2ClientAddon{NameIdentifierFormat: &zeroValue}
-	s.GetNameIdentifierFormat()
-	s = &SAML2ClientAddon{}
-	s.GetNameIdentifierFormat()
+func TestSession_GetIdleExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{IdleExpiresAt: &zeroValue}
+	s.GetIdleExpiresAt()
+	s = &Session{}
+	s.GetIdleExpiresAt()
 	s = nil
-	s.GetNameIdentifierFormat()
+	s.GetIdleExpiresAt()
 }
 
-func TestSAML2ClientAddon_GetNameIdentifierProbes(tt *testing.T) {
-	var zeroValue []string
-	s := &SAML2ClientAddon{NameIdentifierProbes: &zeroValue}
-	s.GetNameIdentifierProbes()
-	s = &SAML2ClientAddon{}
-	s.GetNameIdentifierProbes()
+func TestSession_GetLastInteractedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{LastInteractedAt: &zeroValue}
+	s.GetLastInteractedAt()
+	s = &Session{}
+	s.GetLastInteractedAt()
 	s = nil
-	s.GetNameIdentifierProbes()
+	s.GetLastInteractedAt()
 }
 
-func TestSAML2ClientAddon_GetPassthroughClaimsWithNoMapping(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{PassthroughClaimsWithNoMapping: &zeroValue}
-	s.GetPassthroughClaimsWithNoMapping()
-	s = &SAML2ClientAddon{}
-	s.GetPassthroughClaimsWithNoMapping()
+func TestSession_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	s := &Session{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &Session{}
+	s.GetUpdatedAt()
 	s = nil
-	s.GetPassthroughClaimsWithNoMapping()
+	s.GetUpdatedAt()
 }
 
-func TestSAML2ClientAddon_GetRecipient(tt *testing.T) {
+func TestSession_GetUserID(tt *testing.T) {
 	var zeroValue string
-	s := &SAML2ClientAddon{Recipient: &zeroValue}
-	s.GetRecipient()
-	s = &SAML2ClientAddon{}
-	s.GetRecipient()
+	s := &Session{UserID: &zeroValue}
+	s.GetUserID()
+	s = &Session{}
+	s.GetUserID()
 	s = nil
-	s.GetRecipient()
+	s.GetUserID()
 }
 
-func TestSAML2ClientAddon_GetSignatureAlgorithm(tt *testing.T) {
-	var zeroValue string
-	s := &SAML2ClientAddon{SignatureAlgorithm: &zeroValue}
-	s.GetSignatureAlgorithm()
-	s = &SAML2ClientAddon{}
-	s.GetSignatureAlgorithm()
-	s = nil
-	s.GetSignatureAlgorithm()
+func TestSession_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &Session{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
 }
 
-func TestSAML2ClientAddon_GetSigningCert(tt *testing.T) {
+func TestSessionAuthentication_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SessionAuthentication{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSessionAuthenticationMethod_GetName(tt *testing.T) {
 	var zeroValue string
-	s := &SAML2ClientAddon{SigningCert: &zeroValue}
-	s.GetSigningCert()
-	s = &SAML2ClientAddon{}
-	s.GetSigningCert()
+	s := &SessionAuthenticationMethod{Name: &zeroValue}
+	s.GetName()
+	s = &SessionAuthenticationMethod{}
+	s.GetName()
 	s = nil
-	s.GetSigningCert()
+	s.GetName()
 }
 
-func TestSAML2ClientAddon_GetSignResponse(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{SignResponse: &zeroValue}
-	s.GetSignResponse()
-	s = &SAML2ClientAddon{}
-	s.GetSignResponse()
+func TestSessionAuthenticationMethod_GetTimestamp(tt *testing.T) {
+	var zeroValue time.Time
+	s := &SessionAuthenticationMethod{Timestamp: &zeroValue}
+	s.GetTimestamp()
+	s = &SessionAuthenticationMethod{}
+	s.GetTimestamp()
 	s = nil
-	s.GetSignResponse()
+	s.GetTimestamp()
 }
 
-func TestSAML2ClientAddon_GetTypedAttributes(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddon{TypedAttributes: &zeroValue}
-	s.GetTypedAttributes()
-	s = &SAML2ClientAddon{}
-	s.GetTypedAttributes()
+func TestSessionAuthenticationMethod_GetType(tt *testing.T) {
+	var zeroValue string
+	s := &SessionAuthenticationMethod{Type: &zeroValue}
+	s.GetType()
+	s = &SessionAuthenticationMethod{}
+	s.GetType()
 	s = nil
-	s.GetTypedAttributes()
+	s.GetType()
 }
 
-func TestSAML2ClientAddon_String(t *testing.T) {
+func TestSessionAuthenticationMethod_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &SAML2ClientAddon{}
+	v := &SessionAuthenticationMethod{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestSAML2ClientAddonLogout_GetCallback(tt *testing.T) {
+func TestSessionClient_GetClientID(tt *testing.T) {
 	var zeroValue string
-	s := &SAML2ClientAddonLogout{Callback: &zeroValue}
-	s.GetCallback()
-	s = &SAML2ClientAddonLogout{}
-	s.GetCallback()
-	s = nil
-	s.GetCallback()
-}
-
-func TestSAML2ClientAddonLogout_GetSLOEnabled(tt *testing.T) {
-	var zeroValue bool
-	s := &SAML2ClientAddonLogout{SLOEnabled: &zeroValue}
-	s.GetSLOEnabled()
-	s = &SAML2ClientAddonLogout{}
-	s.GetSLOEnabled()
+	s := &SessionClient{ClientID: &zeroValue}
+	s.GetClientID()
+	s = &SessionClient{}
+	s.GetClientID()
 	s = nil
-	s.GetSLOEnabled()
+	s.GetClientID()
 }
 
-func TestSAML2ClientAddonLogout_String(t *testing.T) {
+func TestSessionClient_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &SAML2ClientAddonLogout{}
+	v := &SessionClient{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestSAPAPIClientAddon_GetClientID(tt *testing.T) {
+func TestSessionDevice_GetInitialASN(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{ClientID: &zeroValue}
-	s.GetClientID()
-	s = &SAPAPIClientAddon{}
-	s.GetClientID()
+	s := &SessionDevice{InitialASN: &zeroValue}
+	s.GetInitialASN()
+	s = &SessionDevice{}
+	s.GetInitialASN()
 	s = nil
-	s.GetClientID()
+	s.GetInitialASN()
 }
 
-func TestSAPAPIClientAddon_GetNameIdentifierFormat(tt *testing.T) {
+func TestSessionDevice_GetInitialIP(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{NameIdentifierFormat: &zeroValue}
-	s.GetNameIdentifierFormat()
-	s = &SAPAPIClientAddon{}
-	s.GetNameIdentifierFormat()
+	s := &SessionDevice{InitialIP: &zeroValue}
+	s.GetInitialIP()
+	s = &SessionDevice{}
+	s.GetInitialIP()
 	s = nil
-	s.GetNameIdentifierFormat()
+	s.GetInitialIP()
 }
 
-func TestSAPAPIClientAddon_GetScope(tt *testing.T) {
+func TestSessionDevice_GetInitialUserAgent(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{Scope: &zeroValue}
-	s.GetScope()
-	s = &SAPAPIClientAddon{}
-	s.GetScope()
+	s := &SessionDevice{InitialUserAgent: &zeroValue}
+	s.GetInitialUserAgent()
+	s = &SessionDevice{}
+	s.GetInitialUserAgent()
 	s = nil
-	s.GetScope()
+	s.GetInitialUserAgent()
 }
 
-func TestSAPAPIClientAddon_GetServicePassword(tt *testing.T) {
+func TestSessionDevice_GetLastASN(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{ServicePassword: &zeroValue}
-	s.GetServicePassword()
-	s = &SAPAPIClientAddon{}
-	s.GetServicePassword()
+	s := &SessionDevice{LastASN: &zeroValue}
+	s.GetLastASN()
+	s = &SessionDevice{}
+	s.GetLastASN()
 	s = nil
-	s.GetServicePassword()
+	s.GetLastASN()
 }
 
-func TestSAPAPIClientAddon_GetTokenEndpointURL(tt *testing.T) {
+func TestSessionDevice_GetLastIP(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{TokenEndpointURL: &zeroValue}
-	s.GetTokenEndpointURL()
-	s = &SAPAPIClientAddon{}
-	s.GetTokenEndpointURL()
+	s := &SessionDevice{LastIP: &zeroValue}
+	s.GetLastIP()
+	s = &SessionDevice{}
+	s.GetLastIP()
 	s = nil
-	s.GetTokenEndpointURL()
+	s.GetLastIP()
 }
 
-func TestSAPAPIClientAddon_GetUsernameAttribute(tt *testing.T) {
+func TestSessionDevice_GetLastUserAgent(tt *testing.T) {
 	var zeroValue string
-	s := &SAPAPIClientAddon{UsernameAttribute: &zeroValue}
-	s.GetUsernameAttribute()
-	s = &SAPAPIClientAddon{}
-	s.GetUsernameAttribute()
+	s := &SessionDevice{LastUserAgent: &zeroValue}
+	s.GetLastUserAgent()
+	s = &SessionDevice{}
+	s.GetLastUserAgent()
 	s = nil
-	s.GetUsernameAttribute()
+	s.GetLastUserAgent()
 }
 
-func TestSAPAPIClientAddon_String(t *testing.T) {
+func TestSessionDevice_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &SAPAPIClientAddon{}
+	v := &SessionDevice{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestSentryClientAddon_GetBaseURL(tt *testing.T) {
-	var zeroValue string
-	s := &SentryClientAddon{BaseURL: &zeroValue}
-	s.GetBaseURL()
-	s = &SentryClientAddon{}
-	s.GetBaseURL()
-	s = nil
-	s.GetBaseURL()
-}
-
-func TestSentryClientAddon_GetOrgSlug(tt *testing.T) {
-	var zeroValue string
-	s := &SentryClientAddon{OrgSlug: &zeroValue}
-	s.GetOrgSlug()
-	s = &SentryClientAddon{}
-	s.GetOrgSlug()
-	s = nil
-	s.GetOrgSlug()
-}
-
-func TestSentryClientAddon_String(t *testing.T) {
+func TestSessionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &SentryClientAddon{}
+	v := &SessionList{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
@@ -12451,6 +14390,60 @@ func TestUser_String(t *testing.T) {
 	}
 }
 
+func TestUserActivity_GetBlock(tt *testing.T) {
+	u := &UserActivity{}
+	u.GetBlock()
+	u = nil
+	u.GetBlock()
+}
+
+func TestUserActivity_GetDate(tt *testing.T) {
+	var zeroValue time.Time
+	u := &UserActivity{Date: &zeroValue}
+	u.GetDate()
+	u = &UserActivity{}
+	u.GetDate()
+	u = nil
+	u.GetDate()
+}
+
+func TestUserActivity_GetEnrollment(tt *testing.T) {
+	u := &UserActivity{}
+	u.GetEnrollment()
+	u = nil
+	u.GetEnrollment()
+}
+
+func TestUserActivity_GetLog(tt *testing.T) {
+	u := &UserActivity{}
+	u.GetLog()
+	u = nil
+	u.GetLog()
+}
+
+func TestUserActivity_GetOrganization(tt *testing.T) {
+	u := &UserActivity{}
+	u.GetOrganization()
+	u = nil
+	u.GetOrganization()
+}
+
+func TestUserActivity_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserActivity{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestUserActivityTimeline_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserActivityTimeline{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestUserBlock_GetIdentifier(tt *testing.T) {
 	var zeroValue string
 	u := &UserBlock{Identifier: &zeroValue}
@@ -100,9 +100,27 @@ type Management struct {
 	// BrandingTheme manages Auth0 Branding Themes.
 	BrandingTheme *BrandingThemeManager
 
+	// BrandingPhoneProvider manages Auth0 Branding Phone Providers.
+	BrandingPhoneProvider *BrandingPhoneProviderManager
+
+	// BrandingPhoneNotificationTemplate manages Auth0 Branding Phone Notification Templates.
+	BrandingPhoneNotificationTemplate *BrandingPhoneNotificationTemplateManager
+
 	// EmailProvider manages Auth0 Email Providers.
 	EmailProvider *EmailProviderManager
 
+	// Session manages Auth0 Sessions.
+	Session *SessionManager
+
+	// RefreshToken manages Auth0 Refresh Tokens.
+	RefreshToken *RefreshTokenManager
+
+	// DeviceCredential manages Auth0 Device Credentials.
+	DeviceCredential *DeviceCredentialManager
+
+	// SelfServiceProfile manages Auth0 Self-Service SSO Profiles.
+	SelfServiceProfile *SelfServiceProfileManager
+
 	url             *url.URL
 	basePath        string
 	userAgent       string
@@ -163,11 +181,14 @@ func New(domain string, options ...Option) (*Management, error) {
 	m.AttackProtection = (*AttackProtectionManager)(&m.common)
 	m.Blacklist = (*BlacklistManager)(&m.common)
 	m.Branding = (*BrandingManager)(&m.common)
+	m.BrandingPhoneNotificationTemplate = (*BrandingPhoneNotificationTemplateManager)(&m.common)
+	m.BrandingPhoneProvider = (*BrandingPhoneProviderManager)(&m.common)
 	m.BrandingTheme = (*BrandingThemeManager)(&m.common)
 	m.Client = (*ClientManager)(&m.common)
 	m.ClientGrant = (*ClientGrantManager)(&m.common)
 	m.Connection = (*ConnectionManager)(&m.common)
 	m.CustomDomain = (*CustomDomainManager)(&m.common)
+	m.DeviceCredential = (*DeviceCredentialManager)(&m.common)
 	m.EmailProvider = (*EmailProviderManager)(&m.common)
 	m.EmailTemplate = (*EmailTemplateManager)(&m.common)
 	m.Grant = (*GrantManager)(&m.common)
@@ -192,10 +213,13 @@ func New(domain string, options ...Option) (*Management, error) {
 	m.LogStream = (*LogStreamManager)(&m.common)
 	m.Organization = (*OrganizationManager)(&m.common)
 	m.Prompt = (*PromptManager)(&m.common)
+	m.RefreshToken = (*RefreshTokenManager)(&m.common)
 	m.ResourceServer = (*ResourceServerManager)(&m.common)
 	m.Role = (*RoleManager)(&m.common)
 	m.Rule = (*RuleManager)(&m.common)
 	m.RuleConfig = (*RuleConfigManager)(&m.common)
+	m.SelfServiceProfile = (*SelfServiceProfileManager)(&m.common)
+	m.Session = (*SessionManager)(&m.common)
 	m.SigningKey = (*SigningKeyManager)(&m.common)
 	m.Stat = (*StatManager)(&m.common)
 	m.Tenant = (*TenantManager)(&m.common)
@@ -0,0 +1,455 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+const (
+	// SourceRule is the kind of drafts migrated from rules.
+	SourceRule = "rule"
+	// SourceHook is the kind of drafts migrated from hooks.
+	SourceHook = "hook"
+
+	// SeverityError marks an issue that must be solved by hand for the action to behave like its source.
+	SeverityError = "error"
+	// SeverityWarning marks an issue that must be checked before deploying the action.
+	SeverityWarning = "warning"
+
+	// Runtime is the Node runtime of the draft actions.
+	Runtime = "node18"
+)
+
+// hookTriggers maps the trigger of a hook to the trigger, and its version, of the equivalent action.
+var hookTriggers = map[string]management.ActionTrigger{
+	management.ActionTriggerCredentialsExchange:  {ID: auth0.String(management.ActionTriggerCredentialsExchange), Version: auth0.String("v2")},
+	management.ActionTriggerPreUserRegistration:  {ID: auth0.String(management.ActionTriggerPreUserRegistration), Version: auth0.String("v2")},
+	management.ActionTriggerPostUserRegistration: {ID: auth0.String(management.ActionTriggerPostUserRegistration), Version: auth0.String("v2")},
+	management.ActionTriggerPostChangePassword:   {ID: auth0.String(management.ActionTriggerPostChangePassword), Version: auth0.String("v2")},
+	management.ActionTriggerSendPhoneMessage:     {ID: auth0.String(management.ActionTriggerSendPhoneMessage), Version: auth0.String("v2")},
+}
+
+// handlers maps triggers to the name of the function actions export for them.
+var handlers = map[string]string{
+	management.ActionTriggerPostLogin:            "onExecutePostLogin",
+	management.ActionTriggerCredentialsExchange:  "onExecuteCredentialsExchange",
+	management.ActionTriggerPreUserRegistration:  "onExecutePreUserRegistration",
+	management.ActionTriggerPostUserRegistration: "onExecutePostUserRegistration",
+	management.ActionTriggerPostChangePassword:   "onExecutePostChangePassword",
+	management.ActionTriggerSendPhoneMessage:     "onExecuteSendPhoneMessage",
+}
+
+// Draft is an action drafted from a rule or a hook.
+type Draft struct {
+	// The action to create. Its code holds the script of the source, to port by hand.
+	Action *management.Action
+	// Either SourceRule or SourceHook.
+	SourceKind string
+	// The ID of the rule or hook.
+	SourceID string
+	// The name of the rule or hook.
+	SourceName string
+	// Whether the rule or hook is enabled. Only drafts of enabled sources are bound.
+	Enabled bool
+	// The order of the rule.
+	Order int
+}
+
+// SetSecret sets the value of a secret of the draft action.
+func (d *Draft) SetSecret(name, value string) {
+	if d.Action.Secrets == nil {
+		d.Action.Secrets = &[]management.ActionSecret{}
+	}
+	secrets := *d.Action.Secrets
+	for i := range secrets {
+		if secrets[i].GetName() == name {
+			secrets[i].Value = auth0.String(value)
+			return
+		}
+	}
+	*d.Action.Secrets = append(secrets, management.ActionSecret{Name: auth0.String(name), Value: auth0.String(value)})
+}
+
+// MissingSecrets returns the names of the secrets of the draft action that have no value yet.
+func (d *Draft) MissingSecrets() []string {
+	var names []string
+	if d.Action.Secrets != nil {
+		for _, secret := range *d.Action.Secrets {
+			if secret.Value == nil {
+				names = append(names, secret.GetName())
+			}
+		}
+	}
+	return names
+}
+
+// Issue is a problem found while migrating a rule or a hook.
+type Issue struct {
+	// Either SeverityError or SeverityWarning.
+	Severity string
+	// Either SourceRule or SourceHook.
+	SourceKind string
+	// The name of the rule or hook.
+	SourceName string
+	// The line of the script the issue was found at, if any.
+	Line int
+	// The API of the script the issue relates to, if any.
+	API string
+	// The description of the issue.
+	Message string
+}
+
+// String returns the issue as a single line.
+func (i *Issue) String() string {
+	location := fmt.Sprintf("%s %q", i.SourceKind, i.SourceName)
+	if i.Line > 0 {
+		location += fmt.Sprintf(" line %d", i.Line)
+	}
+	if i.API != "" {
+		return fmt.Sprintf("%s: %s: %s: %s", i.Severity, location, i.API, i.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", i.Severity, location, i.Message)
+}
+
+// PlannedBinding is the binding of a draft action to its trigger.
+type PlannedBinding struct {
+	// The trigger to bind the action to.
+	TriggerID string
+	// The name of the action.
+	ActionName string
+	// The position of the action in the binding order of the trigger, starting at zero.
+	Position int
+}
+
+// Migration is the plan to migrate the rules and hooks of a tenant to actions.
+type Migration struct {
+	// The draft actions, rules first in their order, then hooks.
+	Drafts []*Draft
+	// The problems found while drafting the actions.
+	Issues []*Issue
+}
+
+// Plan drafts an action for every rule and hook of the source.
+//
+// Rules become `post-login` actions, hooks become actions of the equivalent trigger. The scripts
+// are not translated: they are kept in the code of the actions, annotated with the Actions
+// equivalent of the APIs they use, while the APIs without equivalent are reported as issues.
+func Plan(source *Source) *Migration {
+	m := &Migration{}
+
+	ruleConfigs := map[string]bool{}
+	for _, ruleConfig := range source.RuleConfigs {
+		ruleConfigs[ruleConfig.GetKey()] = true
+	}
+
+	rules := append([]*management.Rule{}, source.Rules...)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].GetOrder() < rules[j].GetOrder()
+	})
+	for _, rule := range rules {
+		m.planRule(rule, ruleConfigs)
+	}
+
+	for _, hook := range source.Hooks {
+		m.planHook(hook, source.HookSecrets[hook.GetID()])
+	}
+
+	return m
+}
+
+func (m *Migration) planRule(rule *management.Rule, ruleConfigs map[string]bool) {
+	script := rule.GetScript()
+	issue := func(severity string, line int, api, message string) {
+		m.Issues = append(m.Issues, &Issue{
+			Severity:   severity,
+			SourceKind: SourceRule,
+			SourceName: rule.GetName(),
+			Line:       line,
+			API:        api,
+			Message:    message,
+		})
+	}
+
+	var secrets []management.ActionSecret
+	for _, key := range configurationKeys(script) {
+		if !ruleConfigs[key] {
+			issue(SeverityError, 0, "configuration."+key, "the rule config does not exist")
+		}
+		secrets = append(secrets, management.ActionSecret{Name: auth0.String(key)})
+	}
+	if len(secrets) > 0 {
+		issue(SeverityWarning, 0, "", "rule config values cannot be read, set the values of the action secrets with SetSecret before creating the draft")
+	}
+
+	modules := requiredModules(script)
+	for _, name := range sortedKeys(modules) {
+		if modules[name] == "" {
+			issue(SeverityWarning, 0, "require", fmt.Sprintf("the version of %q is not pinned, the latest version is used", name))
+		}
+	}
+
+	usages := scan(script, ruleAPIs)
+	for _, usage := range usages {
+		if usage.api.replacement == "" {
+			issue(SeverityError, usage.line, usage.api.name, usage.api.reason)
+		}
+	}
+
+	action := &management.Action{
+		Name: rule.Name,
+		SupportedTriggers: []management.ActionTrigger{
+			{ID: auth0.String(management.ActionTriggerPostLogin), Version: auth0.String("v3")},
+		},
+		Code:         auth0.String(draftCode(SourceRule, rule.GetName(), management.ActionTriggerPostLogin, script, usages)),
+		Dependencies: dependencies(modules),
+		Runtime:      auth0.String(Runtime),
+	}
+	if len(secrets) > 0 {
+		action.Secrets = &secrets
+	}
+
+	m.Drafts = append(m.Drafts, &Draft{
+		Action:     action,
+		SourceKind: SourceRule,
+		SourceID:   rule.GetID(),
+		SourceName: rule.GetName(),
+		Enabled:    rule.GetEnabled(),
+		Order:      rule.GetOrder(),
+	})
+}
+
+func (m *Migration) planHook(hook *management.Hook, hookSecrets management.HookSecrets) {
+	script := hook.GetScript()
+	issue := func(severity string, line int, api, message string) {
+		m.Issues = append(m.Issues, &Issue{
+			Severity:   severity,
+			SourceKind: SourceHook,
+			SourceName: hook.GetName(),
+			Line:       line,
+			API:        api,
+			Message:    message,
+		})
+	}
+
+	trigger, ok := hookTriggers[hook.GetTriggerID()]
+	if !ok {
+		issue(SeverityError, 0, "", fmt.Sprintf("the %q trigger has no Actions equivalent", hook.GetTriggerID()))
+		return
+	}
+
+	var secrets []management.ActionSecret
+	for _, key := range sortedKeys(hookSecrets) {
+		secrets = append(secrets, management.ActionSecret{Name: auth0.String(key)})
+	}
+	if len(secrets) > 0 {
+		issue(SeverityWarning, 0, "", "hook secret values cannot be read, set the values of the action secrets with SetSecret before creating the draft")
+	}
+
+	modules := map[string]string{}
+	if hook.Dependencies != nil {
+		for name, version := range *hook.Dependencies {
+			modules[name] = version
+		}
+	}
+	for name, version := range requiredModules(script) {
+		if _, ok := modules[name]; !ok {
+			modules[name] = version
+		}
+	}
+
+	usages := scan(script, hookAPIs)
+	for _, usage := range usages {
+		if usage.api.replacement == "" {
+			issue(SeverityError, usage.line, usage.api.name, usage.api.reason)
+		}
+	}
+
+	action := &management.Action{
+		Name:              hook.Name,
+		SupportedTriggers: []management.ActionTrigger{trigger},
+		Code:              auth0.String(draftCode(SourceHook, hook.GetName(), trigger.GetID(), script, usages)),
+		Dependencies:      dependencies(modules),
+		Runtime:           auth0.String(Runtime),
+	}
+	if len(secrets) > 0 {
+		action.Secrets = &secrets
+	}
+
+	m.Drafts = append(m.Drafts, &Draft{
+		Action:     action,
+		SourceKind: SourceHook,
+		SourceID:   hook.GetID(),
+		SourceName: hook.GetName(),
+		Enabled:    hook.GetEnabled(),
+	})
+}
+
+// draftCode returns the code of a draft action: a handler for the trigger, holding the script of
+// the source commented out, along with the Actions equivalent of the APIs it uses.
+func draftCode(sourceKind, sourceName, triggerID, script string, usages []usage) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "/**\n * Migrated from the %s %q.\n *\n", sourceKind, sourceName)
+	b.WriteString(" * TODO: port the script below to the Actions API and remove it.\n")
+
+	var hints []string
+	for _, usage := range usages {
+		if usage.api.replacement != "" {
+			hints = append(hints, fmt.Sprintf(" *   line %d: %s -> %s\n", usage.line, usage.api.name, usage.api.replacement))
+		} else {
+			hints = append(hints, fmt.Sprintf(" *   line %d: %s is not supported: %s\n", usage.line, usage.api.name, usage.api.reason))
+		}
+	}
+	if len(hints) > 0 {
+		b.WriteString(" *\n * Equivalents:\n")
+		for _, hint := range hints {
+			b.WriteString(hint)
+		}
+	}
+	b.WriteString(" */\n")
+
+	fmt.Fprintf(&b, "exports.%s = async (event, api) => {\n", handlers[triggerID])
+	for _, line := range strings.Split(strings.TrimRight(script, "\n"), "\n") {
+		b.WriteString(strings.TrimRight("  // "+line, " ") + "\n")
+	}
+	b.WriteString("};\n")
+
+	return b.String()
+}
+
+// Bindings returns the ordered plan to bind the drafts of enabled rules and hooks to their
+// triggers, rules in their order first.
+func (m *Migration) Bindings() []*PlannedBinding {
+	var bindings []*PlannedBinding
+	positions := map[string]int{}
+	for _, draft := range m.Drafts {
+		if !draft.Enabled {
+			continue
+		}
+
+		triggerID := draft.Action.SupportedTriggers[0].GetID()
+		bindings = append(bindings, &PlannedBinding{
+			TriggerID:  triggerID,
+			ActionName: draft.Action.GetName(),
+			Position:   positions[triggerID],
+		})
+		positions[triggerID]++
+	}
+	return bindings
+}
+
+// ActionBindings returns the bindings planned for the trigger, referencing the actions by name,
+// to pass to ActionManager.UpdateBindings once the actions are deployed.
+func (m *Migration) ActionBindings(triggerID string) []*management.ActionBinding {
+	var bindings []*management.ActionBinding
+	for _, binding := range m.Bindings() {
+		if binding.TriggerID != triggerID {
+			continue
+		}
+		bindings = append(bindings, &management.ActionBinding{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByName),
+				Value: auth0.String(binding.ActionName),
+			},
+			DisplayName: auth0.String(binding.ActionName),
+		})
+	}
+	return bindings
+}
+
+// HasErrors returns true if an issue must be solved by hand for an action to behave like its source.
+func (m *Migration) HasErrors() bool {
+	for _, issue := range m.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateDrafts creates the draft actions, or updates the actions of the same name, without deploying
+// them. The ID of each draft action is set once it is saved.
+//
+// Rule config and hook secret values cannot be read, so every secret of the drafts must be set with
+// Draft.SetSecret first. Nothing is saved while a secret has no value.
+func (m *Migration) CreateDrafts(ctx context.Context, api *management.Management, opts ...management.RequestOption) error {
+	var missing []string
+	for _, draft := range m.Drafts {
+		if names := draft.MissingSecrets(); len(names) > 0 {
+			missing = append(missing, fmt.Sprintf("%q (%s)", draft.Action.GetName(), strings.Join(names, ", ")))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the secrets of the actions %s have no value, set them with SetSecret", strings.Join(missing, ", "))
+	}
+
+	for _, draft := range m.Drafts {
+		existing, err := api.Action.List(ctx, withOption(opts, management.Parameter("actionName", draft.Action.GetName()))...)
+		if err != nil {
+			return fmt.Errorf("failed to look up the action %q: %w", draft.Action.GetName(), err)
+		}
+
+		var id string
+		for _, action := range existing.Actions {
+			if action.GetName() == draft.Action.GetName() {
+				id = action.GetID()
+				break
+			}
+		}
+
+		if id == "" {
+			err = api.Action.Create(ctx, draft.Action, opts...)
+		} else {
+			err = api.Action.Update(ctx, id, draft.Action, opts...)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save the action %q drafted from the %s %q: %w", draft.Action.GetName(), draft.SourceKind, draft.SourceName, err)
+		}
+	}
+
+	return nil
+}
+
+// Report returns a human readable summary of the migration: the drafts along with their secrets that
+// have no value yet, the binding plan and the issues.
+func (m *Migration) Report() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Drafts (%d):\n", len(m.Drafts))
+	for _, draft := range m.Drafts {
+		state := "enabled"
+		if !draft.Enabled {
+			state = "disabled, not bound"
+		}
+		fmt.Fprintf(&b, "  %s %q -> %s action %q (%s)\n",
+			draft.SourceKind, draft.SourceName, draft.Action.SupportedTriggers[0].GetID(), draft.Action.GetName(), state)
+		if names := draft.MissingSecrets(); len(names) > 0 {
+			fmt.Fprintf(&b, "    secrets without a value: %s\n", strings.Join(names, ", "))
+		}
+	}
+
+	b.WriteString("Bindings:\n")
+	for _, binding := range m.Bindings() {
+		fmt.Fprintf(&b, "  %s #%d: %q\n", binding.TriggerID, binding.Position+1, binding.ActionName)
+	}
+
+	fmt.Fprintf(&b, "Issues (%d):\n", len(m.Issues))
+	for _, issue := range m.Issues {
+		fmt.Fprintf(&b, "  %s\n", issue)
+	}
+
+	return b.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,183 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+const enrichRule = `function enrich(user, context, callback) {
+  const _ = require('lodash@4.17.21');
+  const request = require('request');
+  const crypto = require('crypto');
+  context.idToken['https://example.com/plan'] = configuration.PLAN_CLAIM;
+  if (context.clientID === configuration['BLOCKED_CLIENT']) {
+    return callback(new UnauthorizedError('blocked'));
+  }
+  global.cache = global.cache || {};
+  // The global object and configuration are not read here.
+  console.log('configuration and global');
+  callback(null, user, context);
+}`
+
+const hookScript = `module.exports = function(client, scope, audience, context, cb) {
+  const axios = require('axios');
+  const key = context.webtask.secrets.API_KEY;
+  context.webtask.storage.get(function () {});
+  cb(null, { scope: scope });
+};`
+
+func givenASource() *Source {
+	return &Source{
+		Rules: []*management.Rule{
+			{ID: auth0.String("rul_2"), Name: auth0.String("second"), Script: auth0.String("function (user, context, callback) {\n  callback(null, user, context);\n}"), Order: auth0.Int(2), Enabled: auth0.Bool(true)},
+			{ID: auth0.String("rul_1"), Name: auth0.String("enrich"), Script: auth0.String(enrichRule), Order: auth0.Int(1), Enabled: auth0.Bool(true)},
+			{ID: auth0.String("rul_3"), Name: auth0.String("disabled"), Script: auth0.String("function (user, context, callback) {}"), Order: auth0.Int(3), Enabled: auth0.Bool(false)},
+		},
+		RuleConfigs: []*management.RuleConfig{{Key: auth0.String("PLAN_CLAIM")}},
+		Hooks: []*management.Hook{
+			{
+				ID:           auth0.String("hook_1"),
+				Name:         auth0.String("scopes"),
+				Script:       auth0.String(hookScript),
+				TriggerID:    auth0.String(management.ActionTriggerCredentialsExchange),
+				Dependencies: &map[string]string{"axios": "1.5.0"},
+				Enabled:      auth0.Bool(true),
+			},
+			{
+				ID:        auth0.String("hook_2"),
+				Name:      auth0.String("legacy"),
+				Script:    auth0.String("module.exports = function() {};"),
+				TriggerID: auth0.String("client-credentials-exchange-legacy"),
+			},
+		},
+		HookSecrets: map[string]management.HookSecrets{
+			"hook_1": {"API_KEY": "_VALUE_NOT_SHOWN_"},
+		},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	m := Plan(givenASource())
+
+	require.Len(t, m.Drafts, 4)
+	assert.Equal(t, []string{"enrich", "second", "disabled", "scopes"}, []string{
+		m.Drafts[0].SourceName, m.Drafts[1].SourceName, m.Drafts[2].SourceName, m.Drafts[3].SourceName,
+	})
+
+	enrich := m.Drafts[0].Action
+	assert.Equal(t, "enrich", enrich.GetName())
+	assert.Equal(t, []management.ActionTrigger{{ID: auth0.String("post-login"), Version: auth0.String("v3")}}, enrich.SupportedTriggers)
+	assert.Equal(t, Runtime, enrich.GetRuntime())
+	assert.Equal(t, []management.ActionDependency{
+		{Name: auth0.String("lodash"), Version: auth0.String("4.17.21")},
+		{Name: auth0.String("request"), Version: auth0.String("latest")},
+	}, *enrich.Dependencies)
+	assert.Equal(t, []management.ActionSecret{
+		{Name: auth0.String("BLOCKED_CLIENT")},
+		{Name: auth0.String("PLAN_CLAIM")},
+	}, *enrich.Secrets)
+	assert.Contains(t, enrich.GetCode(), " *   line 5: context.idToken -> api.idToken.setCustomClaim(name, value)\n")
+	assert.Contains(t, enrich.GetCode(), " *   line 9: global is not supported: ")
+	assert.Contains(t, enrich.GetCode(), "exports.onExecutePostLogin = async (event, api) => {\n  // function enrich(user, context, callback) {\n")
+
+	scopes := m.Drafts[3].Action
+	assert.Equal(t, []management.ActionTrigger{{ID: auth0.String("credentials-exchange"), Version: auth0.String("v2")}}, scopes.SupportedTriggers)
+	assert.Equal(t, []management.ActionDependency{{Name: auth0.String("axios"), Version: auth0.String("1.5.0")}}, *scopes.Dependencies)
+	assert.Equal(t, []management.ActionSecret{{Name: auth0.String("API_KEY")}}, *scopes.Secrets)
+	assert.Contains(t, scopes.GetCode(), "exports.onExecuteCredentialsExchange = async (event, api) => {\n")
+
+	var issues []string
+	for _, issue := range m.Issues {
+		issues = append(issues, issue.String())
+	}
+	assert.Equal(t, []string{
+		`error: rule "enrich": configuration.BLOCKED_CLIENT: the rule config does not exist`,
+		`warning: rule "enrich": rule config values cannot be read, set the values of the action secrets with SetSecret before creating the draft`,
+		`warning: rule "enrich": require: the version of "request" is not pinned, the latest version is used`,
+		`error: rule "enrich" line 9: global: Actions do not share a global object between executions, use api.cache instead`,
+		`warning: hook "scopes": hook secret values cannot be read, set the values of the action secrets with SetSecret before creating the draft`,
+		`error: hook "scopes" line 4: context.webtask.storage: webtask storage is not available to Actions, use api.cache or an external store`,
+		`error: hook "legacy": the "client-credentials-exchange-legacy" trigger has no Actions equivalent`,
+	}, issues)
+	assert.True(t, m.HasErrors())
+}
+
+func TestMigration_Bindings(t *testing.T) {
+	m := Plan(givenASource())
+
+	assert.Equal(t, []*PlannedBinding{
+		{TriggerID: "post-login", ActionName: "enrich", Position: 0},
+		{TriggerID: "post-login", ActionName: "second", Position: 1},
+		{TriggerID: "credentials-exchange", ActionName: "scopes", Position: 0},
+	}, m.Bindings())
+
+	bindings := m.ActionBindings(management.ActionTriggerPostLogin)
+	require.Len(t, bindings, 2)
+	assert.Equal(t, management.ActionBindingReferenceByName, bindings[0].GetRef().GetType())
+	assert.Equal(t, "enrich", bindings[0].GetRef().GetValue())
+	assert.Equal(t, "second", bindings[1].GetRef().GetValue())
+
+	assert.Contains(t, m.Report(), "  rule \"disabled\" -> post-login action \"disabled\" (disabled, not bound)\n")
+	assert.Contains(t, m.Report(), "Bindings:\n  post-login #1: \"enrich\"\n  post-login #2: \"second\"\n  credentials-exchange #1: \"scopes\"\n")
+	assert.Contains(t, m.Report(), "Issues (7):\n")
+}
+
+func TestDraft_SetSecret(t *testing.T) {
+	m := Plan(givenASource())
+	enrich := m.Drafts[0]
+
+	assert.Equal(t, []string{"BLOCKED_CLIENT", "PLAN_CLAIM"}, enrich.MissingSecrets())
+	assert.Contains(t, m.Report(), "  rule \"enrich\" -> post-login action \"enrich\" (enabled)\n    secrets without a value: BLOCKED_CLIENT, PLAN_CLAIM\n")
+
+	enrich.SetSecret("PLAN_CLAIM", "plan")
+	enrich.SetSecret("EXTRA", "extra")
+
+	assert.Equal(t, []string{"BLOCKED_CLIENT"}, enrich.MissingSecrets())
+	assert.Equal(t, []management.ActionSecret{
+		{Name: auth0.String("BLOCKED_CLIENT")},
+		{Name: auth0.String("PLAN_CLAIM"), Value: auth0.String("plan")},
+		{Name: auth0.String("EXTRA"), Value: auth0.String("extra")},
+	}, *enrich.Action.Secrets)
+	assert.Empty(t, m.Drafts[1].MissingSecrets())
+}
+
+func TestScan_IgnoresCommentsAndStrings(t *testing.T) {
+	usages := scan(`// global.cache is not used
+const name = 'global.cache';
+/* configuration.KEY
+   global.cache */
+const value = "a \" global.cache" + configuration.KEY;
+const template = `+"`"+`
+global.cache
+`+"`"+`;
+global['cache'] = configuration;`, ruleAPIs)
+
+	var found []string
+	for _, usage := range usages {
+		found = append(found, fmt.Sprintf("%d:%s", usage.line, usage.api.name))
+	}
+	assert.Equal(t, []string{"5:configuration", "9:global"}, found)
+}
+
+func TestRequiredModules(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"lodash":       "4.17.21",
+		"@scope/pkg":   "1.0.0",
+		"@scope/other": "",
+		"jose":         "",
+	}, requiredModules(`
+		require('lodash@4.17.21');
+		require("@scope/pkg@1.0.0");
+		require('@scope/other/sub');
+		require('jose/jwt/verify');
+		require('./local');
+		require('node:crypto');
+		require('url');
+	`))
+}
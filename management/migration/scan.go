@@ -0,0 +1,240 @@
+package migration
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+// scriptAPI is an API available to Rules or Hooks scripts, along with its Actions equivalent.
+type scriptAPI struct {
+	pattern *regexp.Regexp
+	// The API as written in scripts.
+	name string
+	// The Actions equivalent, empty if there is none.
+	replacement string
+	// Why the API cannot be migrated, when it has no equivalent.
+	reason string
+}
+
+var ruleAPIs = []scriptAPI{
+	{pattern: regexp.MustCompile(`\bcontext\.clientID\b`), name: "context.clientID", replacement: "event.client.client_id"},
+	{pattern: regexp.MustCompile(`\bcontext\.clientName\b`), name: "context.clientName", replacement: "event.client.name"},
+	{pattern: regexp.MustCompile(`\bcontext\.clientMetadata\b`), name: "context.clientMetadata", replacement: "event.client.metadata"},
+	{pattern: regexp.MustCompile(`\bcontext\.connection\b`), name: "context.connection", replacement: "event.connection.name"},
+	{pattern: regexp.MustCompile(`\bcontext\.connectionID\b`), name: "context.connectionID", replacement: "event.connection.id"},
+	{pattern: regexp.MustCompile(`\bcontext\.connectionStrategy\b`), name: "context.connectionStrategy", replacement: "event.connection.strategy"},
+	{pattern: regexp.MustCompile(`\bcontext\.connectionMetadata\b`), name: "context.connectionMetadata", replacement: "event.connection.metadata"},
+	{pattern: regexp.MustCompile(`\bcontext\.protocol\b`), name: "context.protocol", replacement: "event.transaction.protocol"},
+	{pattern: regexp.MustCompile(`\bcontext\.request\b`), name: "context.request", replacement: "event.request"},
+	{pattern: regexp.MustCompile(`\bcontext\.stats\b`), name: "context.stats", replacement: "event.stats"},
+	{pattern: regexp.MustCompile(`\bcontext\.authentication\b`), name: "context.authentication", replacement: "event.authentication"},
+	{pattern: regexp.MustCompile(`\bcontext\.authorization\b`), name: "context.authorization", replacement: "event.authorization"},
+	{pattern: regexp.MustCompile(`\bcontext\.organization\b`), name: "context.organization", replacement: "event.organization"},
+	{pattern: regexp.MustCompile(`\bcontext\.sessionID\b`), name: "context.sessionID", replacement: "event.session.id"},
+	{pattern: regexp.MustCompile(`\bcontext\.tenant\b`), name: "context.tenant", replacement: "event.tenant.id"},
+	{pattern: regexp.MustCompile(`\bcontext\.idToken\b`), name: "context.idToken", replacement: "api.idToken.setCustomClaim(name, value)"},
+	{pattern: regexp.MustCompile(`\bcontext\.accessToken\b`), name: "context.accessToken", replacement: "api.accessToken.setCustomClaim(name, value)"},
+	{pattern: regexp.MustCompile(`\bcontext\.multifactor\b`), name: "context.multifactor", replacement: "api.multifactor.enable(provider)"},
+	{pattern: regexp.MustCompile(`\bcontext\.redirect\b`), name: "context.redirect", replacement: "api.redirect.sendUserTo(url)"},
+	{pattern: regexp.MustCompile(`\bcontext\.samlConfiguration\b`), name: "context.samlConfiguration", replacement: "api.samlResponse"},
+	{pattern: regexp.MustCompile(`\bconfiguration\s*[.[]`), name: "configuration", replacement: "event.secrets"},
+	{pattern: regexp.MustCompile(`\bauth0\.users\.updateAppMetadata\b`), name: "auth0.users.updateAppMetadata", replacement: "api.user.setAppMetadata(name, value)"},
+	{pattern: regexp.MustCompile(`\bauth0\.users\.updateUserMetadata\b`), name: "auth0.users.updateUserMetadata", replacement: "api.user.setUserMetadata(name, value)"},
+	{pattern: regexp.MustCompile(`\bUnauthorizedError\b`), name: "UnauthorizedError", replacement: "api.access.deny(reason)"},
+	{
+		pattern: regexp.MustCompile(`\bcontext\.sso\b`),
+		name:    "context.sso",
+		reason:  "single sign-on details are not available to Actions",
+	},
+	{
+		pattern: regexp.MustCompile(`\bcontext\.primaryUser\b`),
+		name:    "context.primaryUser",
+		reason:  "Actions cannot switch the user logging in, account linking must happen before the login completes",
+	},
+	{
+		pattern: regexp.MustCompile(`\bcontext\.jwtConfiguration\b`),
+		name:    "context.jwtConfiguration",
+		reason:  "Actions cannot change the lifetime or the signing of tokens",
+	},
+	{
+		pattern: regexp.MustCompile(`\bglobal\s*[.[]`),
+		name:    "global",
+		reason:  "Actions do not share a global object between executions, use api.cache instead",
+	},
+	{
+		pattern: regexp.MustCompile(`\bauth0\.(accessToken|domain|baseUrl)\b`),
+		name:    "auth0.accessToken",
+		reason:  "Actions have no Management API token, use the credentials of a machine-to-machine application",
+	},
+	{
+		pattern: regexp.MustCompile(`\bauth0\.users\.(get\w*|updateUser|delete\w*|link\w*|unlink\w*)\b`),
+		name:    "auth0.users",
+		reason:  "Actions have no Management API client, use the credentials of a machine-to-machine application",
+	},
+}
+
+var hookAPIs = []scriptAPI{
+	{pattern: regexp.MustCompile(`\bcontext\.webtask\.secrets\b`), name: "context.webtask.secrets", replacement: "event.secrets"},
+	{
+		pattern: regexp.MustCompile(`\bcontext\.webtask\.storage\b`),
+		name:    "context.webtask.storage",
+		reason:  "webtask storage is not available to Actions, use api.cache or an external store",
+	},
+	{
+		pattern: regexp.MustCompile(`\bcontext\.webtask\.(meta|headers|query|body|params|data|token)\b`),
+		name:    "context.webtask",
+		reason:  "the webtask context is not available to Actions",
+	},
+	{
+		pattern: regexp.MustCompile(`\bglobal\s*[.[]`),
+		name:    "global",
+		reason:  "Actions do not share a global object between executions, use api.cache instead",
+	},
+}
+
+// usage is the use of a script API on a line of a script.
+type usage struct {
+	line int
+	api  scriptAPI
+}
+
+// scan returns the uses of the APIs in the script, in the order they appear. Comments and the
+// contents of string literals are ignored.
+func scan(script string, apis []scriptAPI) []usage {
+	var usages []usage
+	for i, line := range strings.Split(stripCommentsAndStrings(script), "\n") {
+		for _, api := range apis {
+			if api.pattern.MatchString(line) {
+				usages = append(usages, usage{line: i + 1, api: api})
+			}
+		}
+	}
+	return usages
+}
+
+// stripCommentsAndStrings blanks out the comments and the contents of the string literals of the
+// script, keeping its lines so that usages are reported on the right line.
+func stripCommentsAndStrings(script string) string {
+	var b strings.Builder
+	var quote byte
+	lineComment, blockComment := false, false
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '\n':
+			lineComment = false
+			if quote != '`' {
+				quote = 0
+			}
+			b.WriteByte(c)
+		case lineComment:
+		case blockComment:
+			if c == '*' && i+1 < len(script) && script[i+1] == '/' {
+				blockComment = false
+				i++
+			}
+		case quote != 0:
+			if c == '\\' && i+1 < len(script) && script[i+1] != '\n' {
+				i++
+			} else if c == quote {
+				quote = 0
+				b.WriteByte(c)
+			}
+		case c == '/' && i+1 < len(script) && script[i+1] == '/':
+			lineComment = true
+		case c == '/' && i+1 < len(script) && script[i+1] == '*':
+			blockComment = true
+			i++
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+var (
+	requirePattern       = regexp.MustCompile(`\brequire\(\s*['"]([^'"]+)['"]\s*\)`)
+	configurationPattern = regexp.MustCompile(`\bconfiguration(?:\.([A-Za-z_$][\w$]*)|\[\s*['"]([^'"]+)['"]\s*\])`)
+)
+
+// builtinModules are the Node.js modules that are not installed as dependencies.
+var builtinModules = map[string]bool{
+	"assert": true, "buffer": true, "child_process": true, "crypto": true, "dns": true, "events": true,
+	"fs": true, "http": true, "https": true, "net": true, "os": true, "path": true, "querystring": true,
+	"stream": true, "string_decoder": true, "tls": true, "url": true, "util": true, "zlib": true,
+}
+
+// requiredModules returns the npm modules required by the script, by name, with the version pinned
+// with `require('name@version')`, or an empty version.
+func requiredModules(script string) map[string]string {
+	modules := map[string]string{}
+	for _, match := range requirePattern.FindAllStringSubmatch(script, -1) {
+		module := strings.TrimPrefix(match[1], "node:")
+
+		name, version := module, ""
+		if at := strings.LastIndex(module, "@"); at > 0 {
+			name, version = module[:at], module[at+1:]
+		}
+		if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "/") {
+			continue
+		}
+		// Only keep the package of requires such as `lodash/fp`.
+		if parts := strings.Split(name, "/"); strings.HasPrefix(name, "@") && len(parts) > 2 {
+			name = parts[0] + "/" + parts[1]
+		} else if !strings.HasPrefix(name, "@") {
+			name = parts[0]
+		}
+		if builtinModules[name] {
+			continue
+		}
+
+		if modules[name] == "" {
+			modules[name] = version
+		}
+	}
+	return modules
+}
+
+// configurationKeys returns the keys of the rule configs the script reads, sorted.
+func configurationKeys(script string) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, match := range configurationPattern.FindAllStringSubmatch(script, -1) {
+		key := match[1] + match[2]
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// dependencies converts the modules to Action dependencies, sorted by name.
+func dependencies(modules map[string]string) *[]management.ActionDependency {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dependencies := make([]management.ActionDependency, 0, len(names))
+	for _, name := range names {
+		version := modules[name]
+		if version == "" {
+			version = "latest"
+		}
+		dependencies = append(dependencies, management.ActionDependency{
+			Name:    auth0.String(name),
+			Version: auth0.String(version),
+		})
+	}
+	return &dependencies
+}
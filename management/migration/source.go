@@ -0,0 +1,68 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+// Source holds the Rules and Hooks of a tenant to migrate to Actions.
+type Source struct {
+	// The rules of the tenant.
+	Rules []*management.Rule
+	// The rule configs of the tenant. Their values cannot be read, only their keys are used.
+	RuleConfigs []*management.RuleConfig
+	// The hooks of the tenant.
+	Hooks []*management.Hook
+	// The secrets of each hook, by hook ID. Their values cannot be read, only their keys are used.
+	HookSecrets map[string]management.HookSecrets
+}
+
+// Load reads every rule, rule config and hook of the tenant, along with the secrets of the hooks.
+func Load(ctx context.Context, api *management.Management, opts ...management.RequestOption) (*Source, error) {
+	source := &Source{HookSecrets: map[string]management.HookSecrets{}}
+
+	for page := 0; ; page++ {
+		rules, err := api.Rule.List(ctx, withOption(opts, management.Page(page))...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the rules: %w", err)
+		}
+		source.Rules = append(source.Rules, rules.Rules...)
+		if !rules.HasNext() {
+			break
+		}
+	}
+
+	ruleConfigs, err := api.RuleConfig.List(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the rule configs: %w", err)
+	}
+	source.RuleConfigs = ruleConfigs
+
+	for page := 0; ; page++ {
+		hooks, err := api.Hook.List(ctx, withOption(opts, management.Page(page))...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the hooks: %w", err)
+		}
+		source.Hooks = append(source.Hooks, hooks.Hooks...)
+		if !hooks.HasNext() {
+			break
+		}
+	}
+
+	for _, hook := range source.Hooks {
+		secrets, err := api.Hook.Secrets(ctx, hook.GetID(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the secrets of hook %q: %w", hook.GetName(), err)
+		}
+		source.HookSecrets[hook.GetID()] = secrets
+	}
+
+	return source, nil
+}
+
+// withOption returns a copy of the options with the option appended, leaving the slice of the caller untouched.
+func withOption(opts []management.RequestOption, opt management.RequestOption) []management.RequestOption {
+	return append(append(make([]management.RequestOption, 0, len(opts)+1), opts...), opt)
+}
@@ -0,0 +1,124 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+	"github.com/ConsultingMD/go-auth0/management"
+)
+
+func TestLoad(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/rules", func(w http.ResponseWriter, r *http.Request) {
+		rules := &management.RuleList{List: management.List{Start: 0, Limit: 1, Total: 2}}
+		rules.Rules = []*management.Rule{{ID: auth0.String("rul_1")}}
+		if r.URL.Query().Get("page") == "1" {
+			rules.Start = 1
+			rules.Rules = []*management.Rule{{ID: auth0.String("rul_2")}}
+		}
+		writeJSON(t, w, rules)
+	})
+	mux.HandleFunc("/api/v2/rules-configs", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []*management.RuleConfig{{Key: auth0.String("PLAN_CLAIM")}})
+	})
+	mux.HandleFunc("/api/v2/hooks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &management.HookList{
+			List:  management.List{Total: 1, Limit: 50},
+			Hooks: []*management.Hook{{ID: auth0.String("hook_1"), Name: auth0.String("scopes")}},
+		})
+	})
+	mux.HandleFunc("/api/v2/hooks/hook_1/secrets", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, management.HookSecrets{"API_KEY": "_VALUE_NOT_SHOWN_"})
+	})
+	api := givenAnAPI(t, mux)
+
+	// Spare capacity would let the page options of the calls overwrite each other.
+	opts := make([]management.RequestOption, 0, 4)
+	source, err := Load(context.Background(), api, opts...)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*management.Rule{{ID: auth0.String("rul_1")}, {ID: auth0.String("rul_2")}}, source.Rules)
+	assert.Equal(t, []*management.RuleConfig{{Key: auth0.String("PLAN_CLAIM")}}, source.RuleConfigs)
+	assert.Len(t, source.Hooks, 1)
+	assert.Equal(t, map[string]management.HookSecrets{"hook_1": {"API_KEY": "_VALUE_NOT_SHOWN_"}}, source.HookSecrets)
+}
+
+func TestMigration_CreateDrafts(t *testing.T) {
+	var created, updated []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/actions/actions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			actions := &management.ActionList{}
+			if r.URL.Query().Get("actionName") == "enrich" {
+				actions.Actions = []*management.Action{{ID: auth0.String("act_1"), Name: auth0.String("enrich")}}
+			}
+			writeJSON(t, w, actions)
+		case http.MethodPost:
+			var action management.Action
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&action))
+			created = append(created, action.GetName())
+			action.ID = auth0.String("act_" + action.GetName())
+			w.WriteHeader(http.StatusCreated)
+			writeJSON(t, w, action)
+		}
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		updated = append(updated, "act_1")
+		writeJSON(t, w, &management.Action{ID: auth0.String("act_1"), Name: auth0.String("enrich")})
+	})
+	mux.HandleFunc("/api/v2/actions/actions/act_1/deploy", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("drafts must not be deployed")
+	})
+	api := givenAnAPI(t, mux)
+
+	m := Plan(givenASource())
+	m.Drafts[0].SetSecret("BLOCKED_CLIENT", "client")
+	m.Drafts[0].SetSecret("PLAN_CLAIM", "plan")
+	m.Drafts[3].SetSecret("API_KEY", "key")
+	err := m.CreateDrafts(context.Background(), api)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"act_1"}, updated)
+	assert.Equal(t, []string{"second", "disabled", "scopes"}, created)
+	assert.Equal(t, "act_1", m.Drafts[0].Action.GetID())
+	assert.Equal(t, "act_scopes", m.Drafts[3].Action.GetID())
+}
+
+func TestMigration_CreateDrafts_RequiresSecretValues(t *testing.T) {
+	api := givenAnAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request must be sent, got %s %s", r.Method, r.URL.Path)
+	}))
+
+	m := Plan(givenASource())
+	m.Drafts[0].SetSecret("PLAN_CLAIM", "plan")
+	err := m.CreateDrafts(context.Background(), api)
+
+	assert.EqualError(t, err, `the secrets of the actions "enrich" (BLOCKED_CLIENT), "scopes" (API_KEY) have no value, set them with SetSecret`)
+}
+
+func givenAnAPI(t *testing.T, h http.Handler) *management.Management {
+	t.Helper()
+
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	api, err := management.New(s.URL, management.WithInsecure())
+	require.NoError(t, err)
+
+	return api
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	t.Helper()
+
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
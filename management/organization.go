@@ -379,3 +379,35 @@ func (m *OrganizationManager) DeleteMemberRoles(ctx context.Context, id string,
 	err = m.management.Request(ctx, "DELETE", m.management.URI("organizations", id, "members", memberID, "roles"), &body, opts...)
 	return
 }
+
+// ClientGrants retrieves the client grants associated with an organization.
+//
+// Results can be filtered using the `audience` and `client_id` parameters.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/get-organization-client-grants
+func (m *OrganizationManager) ClientGrants(ctx context.Context, id string, opts ...RequestOption) (g *ClientGrantList, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("organizations", id, "client-grants"), &g, applyListDefaults(opts))
+	return
+}
+
+// AssociateClientGrant associates a client grant with an organization, allowing the client to
+// request access tokens for the organization using the client credentials grant.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/create-organization-client-grants
+func (m *OrganizationManager) AssociateClientGrant(ctx context.Context, id string, grantID string, opts ...RequestOption) (err error) {
+	body := struct {
+		GrantID string `json:"grant_id"`
+	}{
+		GrantID: grantID,
+	}
+	err = m.management.Request(ctx, "POST", m.management.URI("organizations", id, "client-grants"), &body, opts...)
+	return
+}
+
+// RemoveClientGrant removes a client grant from an organization.
+//
+// See: https://auth0.com/docs/api/management/v2/organizations/delete-client-grants-by-grant-id
+func (m *OrganizationManager) RemoveClientGrant(ctx context.Context, id string, grantID string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("organizations", id, "client-grants", grantID), nil, opts...)
+	return
+}
@@ -366,6 +366,39 @@ func TestOrganizationManager_MemberRoles(t *testing.T) {
 	assert.Len(t, roles.Roles, 0)
 }
 
+func TestOrganizationManager_AssociateClientGrant(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	org := givenAnOrganization(t)
+	clientGrant := givenAnOrganizationClientGrant(t)
+
+	err := api.Organization.AssociateClientGrant(context.Background(), org.GetID(), clientGrant.GetID())
+	require.NoError(t, err)
+
+	clientGrants, err := api.Organization.ClientGrants(context.Background(), org.GetID())
+	require.NoError(t, err)
+	require.Len(t, clientGrants.ClientGrants, 1)
+	assert.Equal(t, clientGrant.GetID(), clientGrants.ClientGrants[0].GetID())
+	assert.Equal(t, "allow", clientGrants.ClientGrants[0].GetOrganizationUsage())
+}
+
+func TestOrganizationManager_RemoveClientGrant(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	org := givenAnOrganization(t)
+	clientGrant := givenAnOrganizationClientGrant(t)
+
+	err := api.Organization.AssociateClientGrant(context.Background(), org.GetID(), clientGrant.GetID())
+	require.NoError(t, err)
+
+	err = api.Organization.RemoveClientGrant(context.Background(), org.GetID(), clientGrant.GetID())
+	require.NoError(t, err)
+
+	clientGrants, err := api.Organization.ClientGrants(context.Background(), org.GetID())
+	require.NoError(t, err)
+	assert.Len(t, clientGrants.ClientGrants, 0)
+}
+
 func givenAnOrganization(t *testing.T) *Organization {
 	org := &Organization{
 		Name:        auth0.String(fmt.Sprintf("test-organization%v", rand.Intn(999))),
@@ -439,3 +472,26 @@ func cleanupOrganization(t *testing.T, orgID string) {
 		}
 	}
 }
+
+func givenAnOrganizationClientGrant(t *testing.T) *ClientGrant {
+	t.Helper()
+
+	client := givenAClient(t)
+	resourceServer := givenAResourceServer(t)
+
+	clientGrant := &ClientGrant{
+		ClientID:          client.ClientID,
+		Audience:          resourceServer.Identifier,
+		Scope:             []string{"create:resource"},
+		OrganizationUsage: auth0.String("allow"),
+	}
+
+	err := api.ClientGrant.Create(context.Background(), clientGrant)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupClientGrant(t, clientGrant.GetID())
+	})
+
+	return clientGrant
+}
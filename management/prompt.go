@@ -16,6 +16,90 @@ type Prompt struct {
 	WebAuthnPlatformFirstFactor *bool `json:"webauthn_platform_first_factor,omitempty"`
 }
 
+// PromptType identifies a Universal Login prompt, such as `login` or `signup`.
+type PromptType string
+
+// The Universal Login prompts.
+const (
+	PromptLogin                     PromptType = "login"
+	PromptLoginID                   PromptType = "login-id"
+	PromptLoginPassword             PromptType = "login-password"
+	PromptLoginPasswordless         PromptType = "login-passwordless"
+	PromptLoginEmailVerification    PromptType = "login-email-verification"
+	PromptSignup                    PromptType = "signup"
+	PromptSignupID                  PromptType = "signup-id"
+	PromptSignupPassword            PromptType = "signup-password"
+	PromptResetPassword             PromptType = "reset-password"
+	PromptConsent                   PromptType = "consent"
+	PromptLogout                    PromptType = "logout"
+	PromptEmailVerification         PromptType = "email-verification"
+	PromptEmailOTPChallenge         PromptType = "email-otp-challenge"
+	PromptOrganizations             PromptType = "organizations"
+	PromptInvitation                PromptType = "invitation"
+	PromptCommon                    PromptType = "common"
+	PromptMFA                       PromptType = "mfa"
+	PromptMFAOTP                    PromptType = "mfa-otp"
+	PromptMFASMS                    PromptType = "mfa-sms"
+	PromptMFAEmail                  PromptType = "mfa-email"
+	PromptMFAPush                   PromptType = "mfa-push"
+	PromptMFAVoice                  PromptType = "mfa-voice"
+	PromptMFAPhone                  PromptType = "mfa-phone"
+	PromptMFAWebAuthn               PromptType = "mfa-webauthn"
+	PromptMFARecoveryCode           PromptType = "mfa-recovery-code"
+	PromptDeviceFlow                PromptType = "device-flow"
+	PromptStatus                    PromptType = "status"
+	PromptCustomizedConsent         PromptType = "customized-consent"
+	PromptPhoneIdentifierEnrollment PromptType = "phone-identifier-enrollment"
+)
+
+// ScreenName identifies a screen of a Universal Login prompt, such as `login` or `reset-password-request`.
+type ScreenName string
+
+// The screens of the Universal Login prompts that support partials or are commonly customized.
+const (
+	ScreenLogin                ScreenName = "login"
+	ScreenLoginID              ScreenName = "login-id"
+	ScreenLoginPassword        ScreenName = "login-password"
+	ScreenSignup               ScreenName = "signup"
+	ScreenSignupID             ScreenName = "signup-id"
+	ScreenSignupPassword       ScreenName = "signup-password"
+	ScreenResetPasswordRequest ScreenName = "reset-password-request"
+	ScreenResetPasswordEmail   ScreenName = "reset-password-email"
+	ScreenResetPassword        ScreenName = "reset-password"
+	ScreenResetPasswordSuccess ScreenName = "reset-password-success"
+	ScreenResetPasswordError   ScreenName = "reset-password-error"
+	ScreenConsent              ScreenName = "consent"
+	ScreenLogout               ScreenName = "logout"
+	ScreenLogoutAborted        ScreenName = "logout-aborted"
+	ScreenLogoutComplete       ScreenName = "logout-complete"
+)
+
+// PromptScreenPartials holds the HTML partials inserted into a screen of a prompt.
+//
+// See: https://auth0.com/docs/customize/universal-login-pages/customize-signup-and-login-prompts
+type PromptScreenPartials struct {
+	// Inserted at the start of the form content.
+	FormContentStart *string `json:"form-content-start,omitempty"`
+
+	// Inserted at the end of the form content.
+	FormContentEnd *string `json:"form-content-end,omitempty"`
+
+	// Inserted at the start of the form footer.
+	FormFooterStart *string `json:"form-footer-start,omitempty"`
+
+	// Inserted at the end of the form footer.
+	FormFooterEnd *string `json:"form-footer-end,omitempty"`
+
+	// Inserted before the secondary actions.
+	SecondaryActionsStart *string `json:"secondary-actions-start,omitempty"`
+
+	// Inserted after the secondary actions.
+	SecondaryActionsEnd *string `json:"secondary-actions-end,omitempty"`
+}
+
+// PromptPartials holds the partials of a prompt keyed by screen.
+type PromptPartials map[ScreenName]*PromptScreenPartials
+
 // PromptManager is used for managing a Prompt.
 type PromptManager manager
 
@@ -49,3 +133,25 @@ func (m *PromptManager) SetCustomText(ctx context.Context, p string, l string, b
 	err = m.management.Request(ctx, "PUT", m.management.URI("prompts", p, "custom-text", l), &b, opts...)
 	return
 }
+
+// Partials retrieves the partials of a prompt. Partials are only supported by the `login`,
+// `login-id`, `login-password`, `login-passwordless`, `signup`, `signup-id` and `signup-password`
+// prompts and require a custom domain and a page template.
+//
+// See: https://auth0.com/docs/api/management/v2/prompts/get-partials
+func (m *PromptManager) Partials(ctx context.Context, prompt PromptType, opts ...RequestOption) (p PromptPartials, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("prompts", string(prompt), "partials"), &p, opts...)
+	return
+}
+
+// SetPartials sets the partials of a prompt. Existing partials will be overwritten, so pass an empty
+// PromptPartials to remove all the partials of a prompt.
+//
+// See: https://auth0.com/docs/api/management/v2/prompts/put-partials
+func (m *PromptManager) SetPartials(ctx context.Context, prompt PromptType, p PromptPartials, opts ...RequestOption) (err error) {
+	if p == nil {
+		p = PromptPartials{}
+	}
+	err = m.management.Request(ctx, "PUT", m.management.URI("prompts", string(prompt), "partials"), &p, opts...)
+	return
+}
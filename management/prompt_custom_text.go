@@ -0,0 +1,273 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// PromptCustomText holds the custom texts of a prompt in one language, keyed by screen and then by
+// text key.
+//
+// See: https://auth0.com/docs/customize/universal-login-pages/customize-login-text-prompts
+type PromptCustomText map[ScreenName]map[string]string
+
+// PromptCustomTextBundle holds the custom texts of several prompts, keyed by prompt and then by
+// language. It is used to export and import custom texts in bulk.
+type PromptCustomTextBundle map[PromptType]map[string]PromptCustomText
+
+// PromptCustomTextKeys lists the known text keys of each screen, keyed by prompt, used by
+// ValidateCustomText. Prompts that are not listed here are not validated.
+//
+// Entries can be added to validate keys of other prompts or keys introduced after this list was written.
+var PromptCustomTextKeys = map[PromptType]map[ScreenName][]string{
+	PromptLogin: {
+		ScreenLogin: append([]string{
+			"pageTitle", "title", "description", "separatorText", "buttonText", "federatedConnectionButtonText",
+			"signupActionLinkText", "signupActionText", "forgotPasswordText", "passwordPlaceholder",
+			"usernamePlaceholder", "emailPlaceholder", "phonePlaceholder", "usernameOrEmailPlaceholder",
+			"phoneOrEmailPlaceholder", "phoneOrUsernamePlaceholder", "phoneOrUsernameOrEmailPlaceholder",
+			"editEmailText", "alertListTitle", "invitationTitle", "invitationDescription", "logoAltText",
+			"showPasswordText", "hidePasswordText", "captchaCodePlaceholder", "captchaMatchExprPlaceholder",
+			"wrong-credentials", "wrong-email-credentials", "wrong-username-credentials",
+			"wrong-phone-credentials", "wrong-email-username-credentials", "wrong-email-phone-credentials",
+			"wrong-phone-username-credentials", "wrong-email-phone-username-credentials",
+		}, promptLoginErrorKeys...),
+	},
+	PromptLoginID: {
+		ScreenLoginID: append([]string{
+			"pageTitle", "title", "description", "separatorText", "buttonText", "federatedConnectionButtonText",
+			"signupActionLinkText", "signupActionText", "passkeyButtonText", "usernamePlaceholder",
+			"emailPlaceholder", "phonePlaceholder", "usernameOrEmailPlaceholder", "phoneOrEmailPlaceholder",
+			"phoneOrUsernamePlaceholder", "phoneOrUsernameOrEmailPlaceholder", "alertListTitle",
+			"invitationTitle", "invitationDescription", "logoAltText", "captchaCodePlaceholder",
+			"captchaMatchExprPlaceholder",
+		}, promptLoginErrorKeys...),
+	},
+	PromptLoginPassword: {
+		ScreenLoginPassword: append([]string{
+			"pageTitle", "title", "description", "buttonText", "forgotPasswordText", "passwordPlaceholder",
+			"editEmailText", "editLinkScreenReadableText", "alertListTitle", "invitationTitle",
+			"invitationDescription", "logoAltText", "showPasswordText", "hidePasswordText",
+			"signupActionLinkText", "signupActionText", "captchaCodePlaceholder", "captchaMatchExprPlaceholder",
+			"wrong-password",
+		}, promptLoginErrorKeys...),
+	},
+	PromptSignup: {
+		ScreenSignup: append([]string{
+			"pageTitle", "title", "description", "separatorText", "buttonText", "federatedConnectionButtonText",
+			"loginActionLinkText", "loginActionText", "passwordPlaceholder", "usernamePlaceholder",
+			"emailPlaceholder", "phonePlaceholder", "emailInUseMessage", "alertListTitle", "invitationTitle",
+			"invitationDescription", "logoAltText", "showPasswordText", "hidePasswordText",
+			"captchaCodePlaceholder", "captchaMatchExprPlaceholder",
+		}, promptSignupErrorKeys...),
+	},
+	PromptSignupID: {
+		ScreenSignupID: append([]string{
+			"pageTitle", "title", "description", "separatorText", "buttonText", "federatedConnectionButtonText",
+			"loginActionLinkText", "loginActionText", "usernamePlaceholder", "emailPlaceholder",
+			"phonePlaceholder", "emailInUseMessage", "alertListTitle", "invitationTitle",
+			"invitationDescription", "logoAltText", "captchaCodePlaceholder", "captchaMatchExprPlaceholder",
+		}, promptSignupErrorKeys...),
+	},
+	PromptSignupPassword: {
+		ScreenSignupPassword: append([]string{
+			"pageTitle", "title", "description", "buttonText", "passwordPlaceholder", "editEmailText",
+			"editLinkScreenReadableText", "loginActionLinkText", "loginActionText", "alertListTitle",
+			"invitationTitle", "invitationDescription", "logoAltText", "showPasswordText", "hidePasswordText",
+			"captchaCodePlaceholder", "captchaMatchExprPlaceholder",
+		}, promptSignupErrorKeys...),
+	},
+	PromptResetPassword: {
+		ScreenResetPasswordRequest: {
+			"pageTitle", "title", "descriptionEmail", "descriptionUsername", "buttonText", "backToLoginLinkText",
+			"emailPlaceholder", "usernameOrEmailPlaceholder", "logoAltText", "invalid-email-format",
+			"auth0-users-validation", "custom-script-error-code", "reset-password-error", "too-many-email",
+			"too-many-requests", "no-email", "no-username",
+		},
+		ScreenResetPasswordEmail: {
+			"pageTitle", "title", "emailDescription", "usernameDescription", "resendLinkText", "logoAltText",
+		},
+		ScreenResetPassword: {
+			"pageTitle", "title", "description", "buttonText", "passwordPlaceholder", "reEnterpasswordPlaceholder",
+			"passwordSecurityText", "logoAltText", "showPasswordText", "hidePasswordText", "auth0-users-validation",
+			"custom-script-error-code", "password-policy-error", "reset-password-error", "no-re-enter-password",
+			"password-mismatch",
+		},
+		ScreenResetPasswordSuccess: {
+			"pageTitle", "eventTitle", "description", "buttonText", "logoAltText",
+		},
+		ScreenResetPasswordError: {
+			"pageTitle", "descriptionExpired", "descriptionGeneric", "descriptionUsed", "eventTitleExpired",
+			"eventTitleGeneric", "eventTitleUsed", "backToLoginLinkText", "logoAltText",
+		},
+	},
+	PromptConsent: {
+		ScreenConsent: {
+			"pageTitle", "title", "pickerTitle", "messageMultipleTenants", "messageSingleTenant",
+			"acceptButtonText", "declineButtonText", "invalid-action", "invalid-audience", "invalid-scope",
+		},
+	},
+	PromptLogout: {
+		ScreenLogout: {
+			"pageTitle", "title", "description", "acceptButtonText", "declineButtonText", "destinationIndicatorText",
+		},
+		ScreenLogoutAborted: {
+			"pageTitle", "eventTitle", "logoAltText",
+		},
+		ScreenLogoutComplete: {
+			"pageTitle", "eventTitle", "logoAltText",
+		},
+	},
+}
+
+var promptLoginErrorKeys = []string{
+	"invalid-code", "invalid-expired-code", "invalid-email-format", "custom-script-error-code",
+	"auth0-users-validation", "authentication-failure", "invalid-connection", "ip-blocked",
+	"no-db-connection", "password-breached", "user-blocked", "same-user-login", "no-email", "no-password",
+	"no-username", "no-phone", "invalid-recaptcha", "invalid-captcha", "too-many-attempts",
+}
+
+var promptSignupErrorKeys = []string{
+	"invalid-email-format", "custom-script-error-code", "auth0-users-validation", "invalid-connection",
+	"ip-blocked", "ip-signup-blocked", "no-db-connection", "password-too-weak", "password-policy-not-conformant",
+	"password-too-common", "password-previously-used", "password-mismatch", "password-contains-user-information",
+	"password-breached", "user-exists", "username-exists", "email-in-use", "no-email", "no-password",
+	"no-username", "no-phone", "invalid-recaptcha", "invalid-captcha", "too-many-attempts",
+}
+
+// ValidateCustomText checks the screens and text keys of the custom texts of a prompt against
+// PromptCustomTextKeys. All the unknown screens and keys are reported in the returned error.
+//
+// Prompts that are not listed in PromptCustomTextKeys are not validated.
+func ValidateCustomText(prompt PromptType, text PromptCustomText) error {
+	screens, ok := PromptCustomTextKeys[prompt]
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, screen := range sortedScreenNames(text) {
+		known, ok := screens[screen]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown screen %q for prompt %q", screen, prompt))
+			continue
+		}
+
+		knownKeys := make(map[string]bool, len(known))
+		for _, key := range known {
+			knownKeys[key] = true
+		}
+
+		keys := make([]string, 0, len(text[screen]))
+		for key := range text[screen] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if !knownKeys[key] {
+				errs = append(errs, fmt.Errorf("unknown key %q for screen %q of prompt %q", key, screen, prompt))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks the custom texts of all the prompts and languages of the bundle using ValidateCustomText.
+func (b PromptCustomTextBundle) Validate() error {
+	var errs []error
+	for _, prompt := range sortedPromptTypes(b) {
+		for _, language := range sortedLanguages(b[prompt]) {
+			if err := ValidateCustomText(prompt, b[prompt][language]); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %q custom text: %w", language, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ExportCustomText retrieves the custom texts of the prompts in each of the languages. Prompts without
+// custom texts in a language are left out of the returned bundle.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Prompts/get_custom_text_by_language
+func (m *PromptManager) ExportCustomText(ctx context.Context, prompts []PromptType, languages []string, opts ...RequestOption) (PromptCustomTextBundle, error) {
+	bundle := PromptCustomTextBundle{}
+
+	for _, prompt := range prompts {
+		for _, language := range languages {
+			var text PromptCustomText
+			err := m.management.Request(ctx, "GET", m.management.URI("prompts", string(prompt), "custom-text", language), &text, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(text) == 0 {
+				continue
+			}
+
+			if bundle[prompt] == nil {
+				bundle[prompt] = map[string]PromptCustomText{}
+			}
+			bundle[prompt][language] = text
+		}
+	}
+
+	return bundle, nil
+}
+
+// ImportCustomText sets the custom texts of all the prompts and languages of the bundle, overwriting
+// their existing texts. The bundle is validated first and nothing is imported if it is invalid.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Prompts/put_custom_text_by_language
+func (m *PromptManager) ImportCustomText(ctx context.Context, bundle PromptCustomTextBundle, opts ...RequestOption) error {
+	if err := bundle.Validate(); err != nil {
+		return err
+	}
+
+	for _, prompt := range sortedPromptTypes(bundle) {
+		for _, language := range sortedLanguages(bundle[prompt]) {
+			text := bundle[prompt][language]
+			if text == nil {
+				text = PromptCustomText{}
+			}
+
+			err := m.management.Request(ctx, "PUT", m.management.URI("prompts", string(prompt), "custom-text", language), &text, opts...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func sortedPromptTypes(b PromptCustomTextBundle) []PromptType {
+	prompts := make([]PromptType, 0, len(b))
+	for prompt := range b {
+		prompts = append(prompts, prompt)
+	}
+	sort.Slice(prompts, func(i, j int) bool { return prompts[i] < prompts[j] })
+	return prompts
+}
+
+func sortedLanguages(texts map[string]PromptCustomText) []string {
+	languages := make([]string, 0, len(texts))
+	for language := range texts {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+func sortedScreenNames(text PromptCustomText) []ScreenName {
+	screens := make([]ScreenName, 0, len(text))
+	for screen := range text {
+		screens = append(screens, screen)
+	}
+	sort.Slice(screens, func(i, j int) bool { return screens[i] < screens[j] })
+	return screens
+}
@@ -78,3 +78,108 @@ func TestPromptCustomText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Welcome", texts["login"].(map[string]interface{})["title"])
 }
+
+func TestPromptManager_Partials(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	t.Cleanup(func() {
+		err := api.Prompt.SetPartials(context.Background(), PromptLogin, nil)
+		require.NoError(t, err)
+	})
+
+	expected := PromptPartials{
+		ScreenLogin: {
+			FormContentEnd: auth0.String("<div>Updated Form Content End</div>"),
+		},
+	}
+
+	err := api.Prompt.SetPartials(context.Background(), PromptLogin, expected)
+	assert.NoError(t, err)
+
+	actual, err := api.Prompt.Partials(context.Background(), PromptLogin)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPromptManager_ExportImportCustomText(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	t.Cleanup(func() {
+		for _, lang := range []string{"en", "fr"} {
+			err := api.Prompt.SetCustomText(context.Background(), string(PromptLogin), lang, map[string]interface{}{})
+			require.NoError(t, err)
+		}
+	})
+
+	bundle := PromptCustomTextBundle{
+		PromptLogin: {
+			"en": {ScreenLogin: {"title": "Welcome"}},
+			"fr": {ScreenLogin: {"title": "Bienvenue"}},
+		},
+	}
+
+	err := api.Prompt.ImportCustomText(context.Background(), bundle)
+	require.NoError(t, err)
+
+	exported, err := api.Prompt.ExportCustomText(context.Background(), []PromptType{PromptLogin}, []string{"en", "fr", "de"})
+	require.NoError(t, err)
+	assert.Equal(t, bundle, exported)
+}
+
+func TestPromptManager_ImportCustomTextValidation(t *testing.T) {
+	bundle := PromptCustomTextBundle{
+		PromptLogin: {
+			"en": {ScreenLogin: {"title": "Welcome", "titel": "Welcome"}},
+		},
+	}
+
+	err := api.Prompt.ImportCustomText(context.Background(), bundle)
+	assert.EqualError(t, err, `invalid "en" custom text: unknown key "titel" for screen "login" of prompt "login"`)
+}
+
+func TestValidateCustomText(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		prompt   PromptType
+		text     PromptCustomText
+		expected string
+	}{
+		{
+			name:   "known keys",
+			prompt: PromptResetPassword,
+			text: PromptCustomText{
+				ScreenResetPasswordRequest: {"title": "Forgot your password?", "no-email": "Please enter an email"},
+				ScreenResetPasswordSuccess: {"eventTitle": "Password changed!"},
+			},
+		},
+		{
+			name:     "unknown screen",
+			prompt:   PromptLoginID,
+			text:     PromptCustomText{ScreenLogin: {"title": "Welcome"}},
+			expected: `unknown screen "login" for prompt "login-id"`,
+		},
+		{
+			name:   "unknown keys",
+			prompt: PromptSignup,
+			text:   PromptCustomText{ScreenSignup: {"tittle": "Sign up", "buttonTxt": "Continue"}},
+			expected: `unknown key "buttonTxt" for screen "signup" of prompt "signup"` + "\n" +
+				`unknown key "tittle" for screen "signup" of prompt "signup"`,
+		},
+		{
+			name:   "prompt without known keys",
+			prompt: PromptMFAOTP,
+			text:   PromptCustomText{"mfa-otp-challenge": {"anything": "goes"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateCustomText(testCase.prompt, testCase.text)
+			if testCase.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, testCase.expected)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package management
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshToken represents a refresh token issued to a user.
+//
+// See: https://auth0.com/docs/secure/tokens/refresh-tokens
+type RefreshToken struct {
+	// The ID of the refresh token.
+	ID *string `json:"id,omitempty"`
+
+	// The ID of the user the refresh token was issued to.
+	UserID *string `json:"user_id,omitempty"`
+
+	// The ID of the client the refresh token was issued to.
+	ClientID *string `json:"client_id,omitempty"`
+
+	// The ID of the session the refresh token is bound to.
+	SessionID *string `json:"session_id,omitempty"`
+
+	// The date and time the refresh token was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the refresh token will expire if idle.
+	IdleExpiresAt *time.Time `json:"idle_expires_at,omitempty"`
+
+	// The date and time the refresh token will expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Metadata about the device the refresh token was issued to and last used on.
+	Device *SessionDevice `json:"device,omitempty"`
+
+	// Whether the refresh token is rotating.
+	Rotating *bool `json:"rotating,omitempty"`
+
+	// The resource servers and scopes the refresh token grants access to.
+	ResourceServers []*RefreshTokenResourceServer `json:"resource_servers,omitempty"`
+}
+
+// RefreshTokenResourceServer is a resource server a RefreshToken grants access to.
+type RefreshTokenResourceServer struct {
+	// The audience of the resource server.
+	Audience *string `json:"audience,omitempty"`
+
+	// The space-separated scopes granted for the resource server.
+	Scopes *string `json:"scopes,omitempty"`
+}
+
+// RefreshTokenList is a list of RefreshTokens.
+type RefreshTokenList struct {
+	List
+	Tokens []*RefreshToken `json:"tokens"`
+}
+
+// RefreshTokenManager manages Auth0 RefreshToken resources.
+type RefreshTokenManager manager
+
+// Read a refresh token by its ID.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Refresh_Tokens/get_refresh_token
+func (m *RefreshTokenManager) Read(ctx context.Context, id string, opts ...RequestOption) (t *RefreshToken, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("refresh-tokens", id), &t, opts...)
+	return
+}
+
+// Delete a refresh token by its ID, revoking it.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Refresh_Tokens/delete_refresh_token
+func (m *RefreshTokenManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("refresh-tokens", id), nil, opts...)
+	return
+}
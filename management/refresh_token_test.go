@@ -0,0 +1,33 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokenManager_Read(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	refreshToken, err := api.RefreshToken.Read(context.Background(), "eGF0bGN6cVp0T1ZHZ0RRd3p3Y0Y")
+	require.NoError(t, err)
+	assert.Equal(t, "eGF0bGN6cVp0T1ZHZ0RRd3p3Y0Y", refreshToken.GetID())
+	assert.Equal(t, "d0Tw_mcc9DeprIoqWwd8E2exOtaOvSNS", refreshToken.GetSessionID())
+	assert.True(t, refreshToken.GetRotating())
+	assert.Equal(t, "https://api.example.com", refreshToken.ResourceServers[0].GetAudience())
+}
+
+func TestRefreshTokenManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	err := api.RefreshToken.Delete(context.Background(), "eGF0bGN6cVp0T1ZHZ0RRd3p3Y0Y")
+	require.NoError(t, err)
+
+	_, err = api.RefreshToken.Read(context.Background(), "eGF0bGN6cVp0T1ZHZ0RRd3p3Y0Y")
+	assert.Error(t, err)
+	assert.Implements(t, (*Error)(nil), err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
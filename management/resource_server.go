@@ -107,3 +107,40 @@ func (m *ResourceServerManager) List(ctx context.Context, opts ...RequestOption)
 	err = m.management.Request(ctx, "GET", m.management.URI("resource-servers"), &rl, applyListDefaults(opts))
 	return
 }
+
+// Clients retrieves the clients that have been granted access to a resource server, identified by its
+// id or audience.
+//
+// The client grants of the resource server are looked up using the `audience` filter and each granted
+// client is then read, so this method makes one request per page of client grants plus one request
+// per client, which can count against the rate limit of the tenant. Use ClientGrantManager.ListByAudience
+// when only the IDs of the clients are needed. The request options are applied when reading the
+// clients, e.g. to only include some of their fields.
+func (m *ResourceServerManager) Clients(ctx context.Context, id string, opts ...RequestOption) ([]*Client, error) {
+	rs, err := m.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []*Client
+	for page := 0; ; page++ {
+		l, err := m.management.ClientGrant.ListByAudience(ctx, rs.GetIdentifier(), Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range l.ClientGrants {
+			c, err := m.management.Client.Read(ctx, g.GetClientID(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			clients = append(clients, c)
+		}
+
+		if !l.HasNext() {
+			break
+		}
+	}
+
+	return clients, nil
+}
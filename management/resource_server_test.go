@@ -108,6 +108,30 @@ func TestResourceServer_List(t *testing.T) {
 	assert.Contains(t, resourceServerList.ResourceServers, &ResourceServer{ID: expectedResourceServer.ID})
 }
 
+func TestResourceServerManager_Clients(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	client := givenAClient(t)
+	resourceServer := givenAResourceServer(t)
+	clientGrant := &ClientGrant{
+		ClientID: client.ClientID,
+		Audience: resourceServer.Identifier,
+		Scope:    []string{"create:resource"},
+	}
+
+	err := api.ClientGrant.Create(context.Background(), clientGrant)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupClientGrant(t, clientGrant.GetID())
+	})
+
+	clients, err := api.ResourceServer.Clients(context.Background(), resourceServer.GetID())
+	assert.NoError(t, err)
+	require.Len(t, clients, 1)
+	assert.Equal(t, client.GetClientID(), clients[0].GetClientID())
+}
+
 func givenAResourceServer(t *testing.T) *ResourceServer {
 	t.Helper()
 
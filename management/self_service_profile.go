@@ -0,0 +1,238 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// SelfServiceProfile defines the settings used when enterprise customers configure their own SSO
+// connection through the Self-Service SSO flow.
+//
+// See: https://auth0.com/docs/authenticate/enterprise-connections/self-service-SSO
+type SelfServiceProfile struct {
+	// The ID of the self-service profile.
+	ID *string `json:"id,omitempty"`
+
+	// The name of the self-service profile.
+	Name *string `json:"name,omitempty"`
+
+	// The description of the self-service profile.
+	Description *string `json:"description,omitempty"`
+
+	// The user attributes to collect from the identity provider of the connection.
+	UserAttributes []*SelfServiceProfileUserAttribute `json:"user_attributes,omitempty"`
+
+	// The branding of the Self-Service SSO flow.
+	Branding *SelfServiceProfileBranding `json:"branding,omitempty"`
+
+	// The connection strategies customers can choose from. Possible values: `oidc`, `samlp`, `waad`,
+	// `google-apps`, `adfs`, `okta`, `keycloak-samlp` or `pingfederate`.
+	AllowedStrategies *[]string `json:"allowed_strategies,omitempty"`
+
+	// The date and time the self-service profile was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the self-service profile was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// SelfServiceProfileUserAttribute is a user attribute collected during the Self-Service SSO flow.
+type SelfServiceProfileUserAttribute struct {
+	// The name of the user attribute.
+	Name *string `json:"name,omitempty"`
+
+	// The description of the user attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Whether the user attribute is optional.
+	IsOptional *bool `json:"is_optional,omitempty"`
+}
+
+// SelfServiceProfileBranding holds the branding of the Self-Service SSO flow.
+type SelfServiceProfileBranding struct {
+	// The URL of the logo displayed in the flow.
+	LogoURL *string `json:"logo_url,omitempty"`
+
+	// The colors of the flow.
+	Colors *SelfServiceProfileBrandingColors `json:"colors,omitempty"`
+}
+
+// SelfServiceProfileBrandingColors holds the colors of the Self-Service SSO flow.
+type SelfServiceProfileBrandingColors struct {
+	// The primary color, as a hex code.
+	Primary *string `json:"primary,omitempty"`
+}
+
+// SelfServiceProfileList is a list of SelfServiceProfiles.
+type SelfServiceProfileList struct {
+	List
+	SelfServiceProfiles []*SelfServiceProfile `json:"self_service_profiles"`
+}
+
+// SelfServiceProfileTicket is an SSO access ticket used by an enterprise customer to configure their
+// connection through the Self-Service SSO flow.
+type SelfServiceProfileTicket struct {
+	// The ID of an existing connection to update. If not set, a new connection is created.
+	ConnectionID *string `json:"connection_id,omitempty"`
+
+	// The configuration of the connection to create.
+	ConnectionConfig *SelfServiceProfileTicketConnectionConfig `json:"connection_config,omitempty"`
+
+	// The IDs of the clients the connection is enabled for.
+	EnabledClients []string `json:"enabled_clients,omitempty"`
+
+	// The organizations the connection is enabled for.
+	EnabledOrganizations []*SelfServiceProfileTicketEnabledOrganization `json:"enabled_organizations,omitempty"`
+
+	// The number of seconds the ticket is valid for. Defaults to 5 days.
+	TTLSec *int `json:"ttl_sec,omitempty"`
+
+	// Whether the customer must verify the domain aliases of the connection.
+	DomainAliasesConfig *SelfServiceProfileTicketDomainAliasesConfig `json:"domain_aliases_config,omitempty"`
+
+	// The URL of the ticket. Set once the ticket has been created.
+	Ticket *string `json:"ticket,omitempty"`
+}
+
+// SelfServiceProfileTicketConnectionConfig is the configuration of a connection created through a
+// SelfServiceProfileTicket.
+type SelfServiceProfileTicketConnectionConfig struct {
+	// The name of the connection.
+	Name *string `json:"name,omitempty"`
+
+	// The display name of the connection.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Whether the connection is a domain level connection.
+	IsDomainConnection *bool `json:"is_domain_connection,omitempty"`
+
+	// Whether the connection is shown as a button on the login page.
+	ShowAsButton *bool `json:"show_as_button,omitempty"`
+
+	// Metadata associated with the connection.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// The options of the connection.
+	Options *SelfServiceProfileTicketConnectionOptions `json:"options,omitempty"`
+}
+
+// SelfServiceProfileTicketConnectionOptions holds the options of a connection created through a
+// SelfServiceProfileTicket.
+type SelfServiceProfileTicketConnectionOptions struct {
+	// The URL of the icon of the connection.
+	IconURL *string `json:"icon_url,omitempty"`
+
+	// The domains of the customer, used for Home Realm Discovery.
+	DomainAliases *[]string `json:"domain_aliases,omitempty"`
+}
+
+// SelfServiceProfileTicketEnabledOrganization is an organization a connection created through a
+// SelfServiceProfileTicket is enabled for.
+type SelfServiceProfileTicketEnabledOrganization struct {
+	// The ID of the organization.
+	OrganizationID *string `json:"organization_id,omitempty"`
+
+	// Whether users logging in with the connection are automatically granted membership in the organization.
+	AssignMembershipOnLogin *bool `json:"assign_membership_on_login,omitempty"`
+
+	// Whether the connection is shown as a button on the organization's login page.
+	ShowAsButton *bool `json:"show_as_button,omitempty"`
+}
+
+// SelfServiceProfileTicketDomainAliasesConfig configures the verification of the domain aliases of a
+// connection created through a SelfServiceProfileTicket.
+type SelfServiceProfileTicketDomainAliasesConfig struct {
+	// Whether domains must be verified. Possible values: `none`, `optional` or `required`.
+	DomainVerification *string `json:"domain_verification,omitempty"`
+}
+
+// SelfServiceProfileManager manages Auth0 SelfServiceProfile resources.
+type SelfServiceProfileManager manager
+
+// Create a self-service profile.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/post-self-service-profiles
+func (m *SelfServiceProfileManager) Create(ctx context.Context, p *SelfServiceProfile, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("self-service-profiles"), p, opts...)
+}
+
+// List self-service profiles.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/get-self-service-profiles
+func (m *SelfServiceProfileManager) List(ctx context.Context, opts ...RequestOption) (p *SelfServiceProfileList, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("self-service-profiles"), &p, applyListDefaults(opts))
+	return
+}
+
+// Read a self-service profile.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/get-self-service-profiles-by-id
+func (m *SelfServiceProfileManager) Read(ctx context.Context, id string, opts ...RequestOption) (p *SelfServiceProfile, err error) {
+	err = m.management.Request(ctx, http.MethodGet, m.management.URI("self-service-profiles", id), &p, opts...)
+	return
+}
+
+// Update a self-service profile.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/patch-self-service-profiles-by-id
+func (m *SelfServiceProfileManager) Update(ctx context.Context, id string, p *SelfServiceProfile, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPatch, m.management.URI("self-service-profiles", id), p, opts...)
+}
+
+// Delete a self-service profile.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/delete-self-service-profiles-by-id
+func (m *SelfServiceProfileManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodDelete, m.management.URI("self-service-profiles", id), nil, opts...)
+}
+
+// CreateTicket creates an SSO access ticket for a self-service profile. Share the URL of the ticket with
+// the enterprise customer so that they can configure their connection.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/post-sso-ticket
+func (m *SelfServiceProfileManager) CreateTicket(ctx context.Context, id string, t *SelfServiceProfileTicket, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("self-service-profiles", id, "sso-ticket"), t, opts...)
+}
+
+// RevokeTicket revokes an SSO access ticket so that it can no longer be used.
+//
+// See: https://auth0.com/docs/api/management/v2/self-service-profiles/post-revoke
+func (m *SelfServiceProfileManager) RevokeTicket(ctx context.Context, id string, ticketID string, opts ...RequestOption) (err error) {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("self-service-profiles", id, "sso-ticket", ticketID, "revoke"), nil, opts...)
+}
+
+// AddConnectionToOrganization enables a connection created through the Self-Service SSO flow for an
+// organization using OrganizationManager.AddConnection. The connection is looked up by the name it was
+// given in the SelfServiceProfileTicketConnectionConfig of the ticket.
+func (m *SelfServiceProfileManager) AddConnectionToOrganization(
+	ctx context.Context,
+	organizationID string,
+	connectionName string,
+	assignMembershipOnLogin bool,
+	opts ...RequestOption,
+) (*OrganizationConnection, error) {
+	c, err := m.management.Connection.ReadByName(ctx, connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	oc := &OrganizationConnection{
+		ConnectionID:            c.ID,
+		AssignMembershipOnLogin: &assignMembershipOnLogin,
+	}
+	if err := m.management.Organization.AddConnection(ctx, organizationID, oc, opts...); err != nil {
+		return nil, err
+	}
+
+	return oc, nil
+}
+
+// EnableForOrganization enables the connection created through the ticket for the organization, so
+// that it does not need to be added to the organization once the customer completes the flow.
+func (t *SelfServiceProfileTicket) EnableForOrganization(o *Organization, assignMembershipOnLogin bool) {
+	t.EnabledOrganizations = append(t.EnabledOrganizations, &SelfServiceProfileTicketEnabledOrganization{
+		OrganizationID:          o.ID,
+		AssignMembershipOnLogin: &assignMembershipOnLogin,
+	})
+}
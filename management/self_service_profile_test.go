@@ -0,0 +1,180 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ConsultingMD/go-auth0"
+)
+
+func TestSelfServiceProfileManager_Create(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	profile := givenASelfServiceProfile(t)
+	assert.NotEmpty(t, profile.GetID())
+	assert.NotEmpty(t, profile.GetCreatedAt())
+}
+
+func TestSelfServiceProfileManager_Read(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenASelfServiceProfile(t)
+
+	actual, err := api.SelfServiceProfile.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, expected.GetName(), actual.GetName())
+	assert.Equal(t, expected.GetAllowedStrategies(), actual.GetAllowedStrategies())
+	assert.Equal(t, "#0059d6", actual.GetBranding().GetColors().GetPrimary())
+	require.Len(t, actual.UserAttributes, 1)
+	assert.Equal(t, "email", actual.UserAttributes[0].GetName())
+}
+
+func TestSelfServiceProfileManager_Update(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenASelfServiceProfile(t)
+
+	err := api.SelfServiceProfile.Update(context.Background(), expected.GetID(), &SelfServiceProfile{
+		Description:       auth0.String("Updated description"),
+		AllowedStrategies: &[]string{"oidc"},
+	})
+	require.NoError(t, err)
+
+	actual, err := api.SelfServiceProfile.Read(context.Background(), expected.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Updated description", actual.GetDescription())
+	assert.Equal(t, []string{"oidc"}, actual.GetAllowedStrategies())
+}
+
+func TestSelfServiceProfileManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenASelfServiceProfile(t)
+
+	err := api.SelfServiceProfile.Delete(context.Background(), expected.GetID())
+	require.NoError(t, err)
+
+	_, err = api.SelfServiceProfile.Read(context.Background(), expected.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
+
+func TestSelfServiceProfileManager_List(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	expected := givenASelfServiceProfile(t)
+
+	profiles, err := api.SelfServiceProfile.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, profiles.SelfServiceProfiles, 1)
+	assert.Equal(t, expected.GetID(), profiles.SelfServiceProfiles[0].GetID())
+}
+
+func TestSelfServiceProfileManager_CreateTicket(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	profile := givenASelfServiceProfile(t)
+	org := givenAnOrganization(t)
+
+	ticket := &SelfServiceProfileTicket{
+		ConnectionConfig: &SelfServiceProfileTicketConnectionConfig{
+			Name: auth0.String("test-self-service-conn"),
+		},
+		TTLSec: auth0.Int(3600),
+	}
+	ticket.EnableForOrganization(org, true)
+
+	err := api.SelfServiceProfile.CreateTicket(context.Background(), profile.GetID(), ticket)
+	require.NoError(t, err)
+	assert.NotEmpty(t, ticket.GetTicket())
+	assert.Equal(t, org.GetID(), ticket.EnabledOrganizations[0].GetOrganizationID())
+}
+
+func TestSelfServiceProfileManager_RevokeTicket(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	profile := givenASelfServiceProfile(t)
+
+	ticket := &SelfServiceProfileTicket{
+		ConnectionConfig: &SelfServiceProfileTicketConnectionConfig{
+			Name: auth0.String("test-self-service-conn"),
+		},
+	}
+	err := api.SelfServiceProfile.CreateTicket(context.Background(), profile.GetID(), ticket)
+	require.NoError(t, err)
+
+	ticketURL, err := url.Parse(ticket.GetTicket())
+	require.NoError(t, err)
+
+	err = api.SelfServiceProfile.RevokeTicket(context.Background(), profile.GetID(), ticketURL.Query().Get("ticket"))
+	assert.NoError(t, err)
+}
+
+func TestSelfServiceProfileManager_AddConnectionToOrganization(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	org := givenAnOrganization(t)
+	conn := givenAConnection(t, connectionTestCase{connection: Connection{
+		Name:     auth0.String("test-self-service-conn"),
+		Strategy: auth0.String(ConnectionStrategyAuth0),
+	}})
+
+	orgConn, err := api.SelfServiceProfile.AddConnectionToOrganization(
+		context.Background(),
+		org.GetID(),
+		conn.GetName(),
+		true,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, conn.GetID(), orgConn.GetConnectionID())
+	assert.True(t, orgConn.GetAssignMembershipOnLogin())
+	assert.Equal(t, conn.GetName(), orgConn.GetConnection().GetName())
+}
+
+func givenASelfServiceProfile(t *testing.T) *SelfServiceProfile {
+	t.Helper()
+
+	profile := &SelfServiceProfile{
+		Name:        auth0.String("Test Self-Service Profile"),
+		Description: auth0.String("Self-Service SSO for our enterprise customers"),
+		UserAttributes: []*SelfServiceProfileUserAttribute{
+			{
+				Name:        auth0.String("email"),
+				Description: auth0.String("Email of the user"),
+				IsOptional:  auth0.Bool(false),
+			},
+		},
+		Branding: &SelfServiceProfileBranding{
+			LogoURL: auth0.String("https://example.com/logo.png"),
+			Colors: &SelfServiceProfileBrandingColors{
+				Primary: auth0.String("#0059d6"),
+			},
+		},
+		AllowedStrategies: &[]string{"oidc", "samlp", "okta"},
+	}
+
+	err := api.SelfServiceProfile.Create(context.Background(), profile)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cleanupSelfServiceProfile(t, profile.GetID())
+	})
+
+	return profile
+}
+
+func cleanupSelfServiceProfile(t *testing.T, id string) {
+	t.Helper()
+
+	err := api.SelfServiceProfile.Delete(context.Background(), id)
+	if err != nil {
+		if err.(Error).Status() != http.StatusNotFound {
+			t.Error(err)
+		}
+	}
+}
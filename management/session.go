@@ -0,0 +1,114 @@
+package management
+
+import (
+	"context"
+	"time"
+)
+
+// Session represents an authenticated session of a user on a tenant.
+//
+// See: https://auth0.com/docs/manage-users/sessions
+type Session struct {
+	// The ID of the session.
+	ID *string `json:"id,omitempty"`
+
+	// The ID of the user the session belongs to.
+	UserID *string `json:"user_id,omitempty"`
+
+	// The date and time the session was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// The date and time the session was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// The date and time the user last authenticated in the session.
+	AuthenticatedAt *time.Time `json:"authenticated_at,omitempty"`
+
+	// The date and time the session will expire if idle.
+	IdleExpiresAt *time.Time `json:"idle_expires_at,omitempty"`
+
+	// The date and time the session will expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// The date and time of the last interaction with the session.
+	LastInteractedAt *time.Time `json:"last_interacted_at,omitempty"`
+
+	// Metadata about the device the session was created and last used on.
+	Device *SessionDevice `json:"device,omitempty"`
+
+	// The applications the session has been used with.
+	Clients []*SessionClient `json:"clients,omitempty"`
+
+	// Details about how the user authenticated in the session.
+	Authentication *SessionAuthentication `json:"authentication,omitempty"`
+}
+
+// SessionDevice holds metadata about the device a Session was used on.
+type SessionDevice struct {
+	// The user agent of the device that created the session.
+	InitialUserAgent *string `json:"initial_user_agent,omitempty"`
+
+	// The IP address of the device that created the session.
+	InitialIP *string `json:"initial_ip,omitempty"`
+
+	// The autonomous system number of the IP address that created the session.
+	InitialASN *string `json:"initial_asn,omitempty"`
+
+	// The user agent of the device that last used the session.
+	LastUserAgent *string `json:"last_user_agent,omitempty"`
+
+	// The IP address of the device that last used the session.
+	LastIP *string `json:"last_ip,omitempty"`
+
+	// The autonomous system number of the IP address that last used the session.
+	LastASN *string `json:"last_asn,omitempty"`
+}
+
+// SessionClient is an application a Session has been used with.
+type SessionClient struct {
+	// The ID of the client.
+	ClientID *string `json:"client_id,omitempty"`
+}
+
+// SessionAuthentication holds the authentication details of a Session.
+type SessionAuthentication struct {
+	// The methods used to authenticate in the session.
+	Methods []*SessionAuthenticationMethod `json:"methods,omitempty"`
+}
+
+// SessionAuthenticationMethod is a method used to authenticate in a Session.
+type SessionAuthenticationMethod struct {
+	// The name of the authentication method, e.g. "pwd", "federated" or "mfa".
+	Name *string `json:"name,omitempty"`
+
+	// The date and time the method was used.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+
+	// The type of the authentication method, only set for "mfa".
+	Type *string `json:"type,omitempty"`
+}
+
+// SessionList is a list of Sessions.
+type SessionList struct {
+	List
+	Sessions []*Session `json:"sessions"`
+}
+
+// SessionManager manages Auth0 Session resources.
+type SessionManager manager
+
+// Read a session by its ID.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Sessions/get_session
+func (m *SessionManager) Read(ctx context.Context, id string, opts ...RequestOption) (s *Session, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("sessions", id), &s, opts...)
+	return
+}
+
+// Delete a session by its ID, logging the user out of it.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Sessions/delete_session
+func (m *SessionManager) Delete(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("sessions", id), nil, opts...)
+	return
+}
@@ -0,0 +1,34 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionManager_Read(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	session, err := api.Session.Read(context.Background(), "d0Tw_mcc9DeprIoqWwd8E2exOtaOvSNS")
+	require.NoError(t, err)
+	assert.Equal(t, "d0Tw_mcc9DeprIoqWwd8E2exOtaOvSNS", session.GetID())
+	assert.Equal(t, "auth0|65cd0e0ca1b2c3d4e5f60718", session.GetUserID())
+	assert.Equal(t, "203.0.113.10", session.GetDevice().GetInitialIP())
+	assert.Len(t, session.Clients, 1)
+	assert.Equal(t, "pwd", session.GetAuthentication().Methods[0].GetName())
+}
+
+func TestSessionManager_Delete(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	err := api.Session.Delete(context.Background(), "d0Tw_mcc9DeprIoqWwd8E2exOtaOvSNS")
+	require.NoError(t, err)
+
+	_, err = api.Session.Read(context.Background(), "d0Tw_mcc9DeprIoqWwd8E2exOtaOvSNS")
+	assert.Error(t, err)
+	assert.Implements(t, (*Error)(nil), err)
+	assert.Equal(t, http.StatusNotFound, err.(Error).Status())
+}
@@ -659,6 +659,17 @@ func (m *UserManager) Organizations(ctx context.Context, id string, opts ...Requ
 	return
 }
 
+// Logs retrieves the log events of a user.
+//
+// Use the Sort option to change the order of the results, e.g. `Sort("date:1")` to list the oldest
+// log events first. By default the most recent log events are returned first.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_logs_by_user
+func (m *UserManager) Logs(ctx context.Context, id string, opts ...RequestOption) (l *LogList, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("users", id, "logs"), &l, applyListDefaults(opts))
+	return
+}
+
 // ListAuthenticationMethods retrieves a list of authentication methods.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_authentication_methods
@@ -714,3 +725,39 @@ func (m *UserManager) DeleteAllAuthenticationMethods(ctx context.Context, userID
 	err = m.management.Request(ctx, "DELETE", m.management.URI("users", userID, "authentication-methods"), nil, opts...)
 	return
 }
+
+// Sessions lists the sessions of a user.
+//
+// This endpoint uses checkpoint pagination, use the From and Take options to page through the results.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_sessions_for_user
+func (m *UserManager) Sessions(ctx context.Context, id string, opts ...RequestOption) (s *SessionList, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("users", id, "sessions"), &s, opts...)
+	return
+}
+
+// DeleteSessions deletes all the sessions of a user, logging them out of every device.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/delete_sessions_for_user
+func (m *UserManager) DeleteSessions(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("users", id, "sessions"), nil, opts...)
+	return
+}
+
+// RefreshTokens lists the refresh tokens issued to a user.
+//
+// This endpoint uses checkpoint pagination, use the From and Take options to page through the results.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/get_refresh_tokens_for_user
+func (m *UserManager) RefreshTokens(ctx context.Context, id string, opts ...RequestOption) (t *RefreshTokenList, err error) {
+	err = m.management.Request(ctx, "GET", m.management.URI("users", id, "refresh-tokens"), &t, opts...)
+	return
+}
+
+// DeleteRefreshTokens revokes all the refresh tokens issued to a user.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Users/delete_refresh_tokens_for_user
+func (m *UserManager) DeleteRefreshTokens(ctx context.Context, id string, opts ...RequestOption) (err error) {
+	err = m.management.Request(ctx, "DELETE", m.management.URI("users", id, "refresh-tokens"), nil, opts...)
+	return
+}
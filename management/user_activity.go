@@ -0,0 +1,142 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// UserActivityTypeLog is the type of UserActivity entries created from log events.
+	UserActivityTypeLog = "log"
+
+	// UserActivityTypeEnrollment is the type of UserActivity entries created from Guardian enrollments.
+	UserActivityTypeEnrollment = "enrollment"
+
+	// UserActivityTypeBlock is the type of UserActivity entries created from blocked IP addresses.
+	UserActivityTypeBlock = "block"
+
+	// UserActivityTypeOrganization is the type of UserActivity entries created from organization memberships.
+	UserActivityTypeOrganization = "organization"
+)
+
+// UserActivity is an entry of a UserActivityTimeline.
+//
+// Exactly one of Log, Enrollment, Block or Organization is set depending on the Type.
+type UserActivity struct {
+	// The type of the entry, one of the UserActivityType constants.
+	Type string
+
+	// The date of the entry. Blocks and organization memberships are not dated, in which case it is nil.
+	Date *time.Time
+
+	// A human readable description of the entry.
+	Description string
+
+	// The log event the entry was created from.
+	Log *Log
+
+	// The Guardian enrollment the entry was created from.
+	Enrollment *UserEnrollment
+
+	// The blocked IP address the entry was created from.
+	Block *UserBlock
+
+	// The organization membership the entry was created from.
+	Organization *Organization
+}
+
+// UserActivityTimeline merges the log events, Guardian enrollments, blocked IP addresses and
+// organization memberships of a user.
+type UserActivityTimeline struct {
+	// The ID of the user.
+	UserID string
+
+	// The entries of the timeline, oldest first. Entries without a date are listed last.
+	Activities []*UserActivity
+}
+
+// ActivityTimeline builds the activity timeline of a user by merging the user's log events,
+// Guardian enrollments, blocked IP addresses and organization memberships.
+//
+// The request options are only applied when retrieving the log events, e.g. `PerPage(100)`
+// to include more log events. By default the 50 most recent log events are included.
+func (m *UserManager) ActivityTimeline(ctx context.Context, id string, opts ...RequestOption) (*UserActivityTimeline, error) {
+	logs, err := m.Logs(ctx, id, append([]RequestOption{Sort("date:-1")}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollments, err := m.Enrollments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := m.Blocks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &UserActivityTimeline{UserID: id}
+
+	for _, l := range logs.Logs {
+		description := l.GetDescription()
+		if description == "" {
+			description = l.TypeName()
+		}
+
+		timeline.Activities = append(timeline.Activities, &UserActivity{
+			Type:        UserActivityTypeLog,
+			Date:        l.Date,
+			Description: description,
+			Log:         l,
+		})
+	}
+
+	for _, e := range enrollments {
+		timeline.Activities = append(timeline.Activities, &UserActivity{
+			Type:        UserActivityTypeEnrollment,
+			Date:        e.EnrolledAt,
+			Description: fmt.Sprintf("Enrolled in %s multi-factor authentication (%s)", e.GetType(), e.GetStatus()),
+			Enrollment:  e,
+		})
+	}
+
+	for _, b := range blocks {
+		timeline.Activities = append(timeline.Activities, &UserActivity{
+			Type:        UserActivityTypeBlock,
+			Description: fmt.Sprintf("Blocked from IP address %s", b.GetIP()),
+			Block:       b,
+		})
+	}
+
+	for page := 0; ; page++ {
+		organizations, err := m.Organizations(ctx, id, Page(page))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range organizations.Organizations {
+			timeline.Activities = append(timeline.Activities, &UserActivity{
+				Type:         UserActivityTypeOrganization,
+				Description:  fmt.Sprintf("Member of organization %s", o.GetName()),
+				Organization: o,
+			})
+		}
+
+		if !organizations.HasNext() {
+			break
+		}
+	}
+
+	sort.SliceStable(timeline.Activities, func(i, j int) bool {
+		a, b := timeline.Activities[i].Date, timeline.Activities[j].Date
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.Before(*b)
+	})
+
+	return timeline, nil
+}
@@ -0,0 +1,37 @@
+package management
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserManager_ActivityTimeline(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	timeline, err := api.User.ActivityTimeline(context.Background(), user.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, user.GetID(), timeline.UserID)
+
+	var types []string
+	for _, activity := range timeline.Activities {
+		types = append(types, activity.Type)
+	}
+	assert.Equal(t, []string{
+		UserActivityTypeLog,
+		UserActivityTypeEnrollment,
+		UserActivityTypeLog,
+		UserActivityTypeBlock,
+		UserActivityTypeOrganization,
+	}, types)
+
+	assert.Equal(t, "Success Signup", timeline.Activities[0].Description)
+	assert.Equal(t, "Enrolled in sms multi-factor authentication (confirmed)", timeline.Activities[1].Description)
+	assert.Equal(t, "Successful login", timeline.Activities[2].Description)
+	assert.Nil(t, timeline.Activities[3].Date)
+	assert.Equal(t, "203.0.113.10", timeline.Activities[3].Block.GetIP())
+	assert.Equal(t, "Member of organization test-organization", timeline.Activities[4].Description)
+}
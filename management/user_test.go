@@ -228,6 +228,42 @@ func TestUserManager_Enrollments(t *testing.T) {
 	assert.Len(t, userEnrollments, 0)
 }
 
+func TestUserManager_Sessions(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	sessions, err := api.User.Sessions(context.Background(), user.GetID())
+	assert.NoError(t, err)
+	assert.Len(t, sessions.Sessions, 0)
+	assert.False(t, sessions.HasNext())
+}
+
+func TestUserManager_DeleteSessions(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	err := api.User.DeleteSessions(context.Background(), user.GetID())
+	assert.NoError(t, err)
+}
+
+func TestUserManager_RefreshTokens(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	refreshTokens, err := api.User.RefreshTokens(context.Background(), user.GetID())
+	assert.NoError(t, err)
+	assert.Len(t, refreshTokens.Tokens, 0)
+	assert.False(t, refreshTokens.HasNext())
+}
+
+func TestUserManager_DeleteRefreshTokens(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	err := api.User.DeleteRefreshTokens(context.Background(), user.GetID())
+	assert.NoError(t, err)
+}
+
 func TestUserManager_RegenerateRecoveryCode(t *testing.T) {
 	configureHTTPTestRecordings(t)
 
@@ -237,6 +273,18 @@ func TestUserManager_RegenerateRecoveryCode(t *testing.T) {
 	assert.NotEmpty(t, recoveryCode)
 }
 
+func TestUserManager_Logs(t *testing.T) {
+	configureHTTPTestRecordings(t)
+
+	user := givenAUser(t)
+	logs, err := api.User.Logs(context.Background(), user.GetID())
+	assert.NoError(t, err)
+	require.Len(t, logs.Logs, 1)
+	assert.Equal(t, "ss", logs.Logs[0].GetType())
+	assert.Equal(t, user.GetID(), logs.Logs[0].GetUserID())
+	assert.False(t, logs.HasNext())
+}
+
 func TestUserManager_InvalidateRememberBrowser(t *testing.T) {
 	configureHTTPTestRecordings(t)
 